@@ -82,6 +82,8 @@ type AddressOption struct {
 	BlockNumber bool
 	// BlockNumber width(1 or 2)
 	NumberWidth int
+	// Output fully random address per column without matching zip code, prefecture and city.
+	Random bool
 }
 
 func (o AddressOption) hasTargetColumn() bool {
@@ -190,6 +192,7 @@ func Address(r io.Reader, w io.Writer, o AddressOption) error {
 		})
 	}
 	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		addr := fakeAddress()
 		newRec := make([]string, len(rec))
 		for i, s := range rec {
 			if !containsInt(cols.indexes(), i) {
@@ -197,22 +200,24 @@ func Address(r io.Reader, w io.Writer, o AddressOption) error {
 				continue
 			}
 
-			addr := gimei.NewAddress()
+			if o.Random {
+				addr = randomAddress()
+			}
 			if i == cols.zipCode.index {
-				newRec[i] += fakeZipCode()
+				newRec[i] += addr.zipCode
 			}
 			if i == cols.prefecture.index {
 				if o.PrefectureCode {
-					newRec[i] += prefCode(addr.Prefecture.Kanji())
+					newRec[i] += prefCode(addr.prefecture)
 				} else {
-					newRec[i] += addr.Prefecture.Kanji()
+					newRec[i] += addr.prefecture
 				}
 			}
 			if i == cols.city.index {
-				newRec[i] += addr.City.Kanji()
+				newRec[i] += addr.city
 			}
 			if i == cols.town.index {
-				newRec[i] += addr.Town.Kanji()
+				newRec[i] += addr.town
 				if o.BlockNumber {
 					newRec[i] += fakeBlockNumber(o.isFullWidthBlockNumber())
 				}
@@ -233,6 +238,22 @@ func setupAddressCols(o AddressOption, hdr []string) *addrCols {
 	return cols
 }
 
+// fakeAddress returns address that zip code, prefecture and city are matched.
+func fakeAddress() postalAddress {
+	return fakePostalAddresses[rand.Intn(len(fakePostalAddresses))]
+}
+
+// randomAddress returns address that zip code, prefecture, city and town are unrelated.
+func randomAddress() postalAddress {
+	addr := gimei.NewAddress()
+	return postalAddress{
+		zipCode:    fakeZipCode(),
+		prefecture: addr.Prefecture.Kanji(),
+		city:       addr.City.Kanji(),
+		town:       addr.Town.Kanji(),
+	}
+}
+
 func fakeZipCode() string {
 	return fmt.Sprintf("%03d-%04d", rand.Intn(1000), rand.Intn(10000))
 }
@@ -526,3 +526,102 @@ func TestAddressWithAppending(t *testing.T) {
 	}
 
 }
+
+func TestAddressWithMatchedColumns(t *testing.T) {
+	s := `zip,pref,city,town
+1,2,3,4
+5,6,7,8
+9,10,11,12
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := AddressOption{
+		ZipCode:     "zip",
+		Prefecture:  "pref",
+		City:        "city",
+		Town:        "town",
+		NumberWidth: 1,
+	}
+
+	if err := Address(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	actual := readCSV(w.String())
+	for i, rec := range actual {
+		if i == 0 {
+			continue
+		}
+		addr := postalAddress{rec[0], rec[1], rec[2], rec[3]}
+		found := false
+		for _, a := range fakePostalAddresses {
+			if a == addr {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("Address not matched: %v, line: %d", rec, i)
+		}
+	}
+}
+
+func TestAddressWithRandom(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+7,8,9
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := AddressOption{
+		ZipCode:     "aaa",
+		Prefecture:  "bbb",
+		NumberWidth: 1,
+		Random:      true,
+	}
+
+	if err := Address(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	rgx := regexp.MustCompile(`^\d{3}-\d{4}$`)
+	actual := readCSV(w.String())
+	for i, rec := range actual {
+		if i == 0 {
+			continue
+		}
+		if !rgx.MatchString(rec[0]) {
+			t.Fatalf("Zip code not found: %s, line: %d", rec[0], i)
+		}
+		if !containsString(prefs, rec[1]) {
+			t.Fatalf("Prefecture not found: %s, line: %d", rec[1], i)
+		}
+	}
+}
+
+func TestFakePostalAddresses(t *testing.T) {
+	rgx := regexp.MustCompile(`^\d{3}-\d{4}$`)
+	for _, a := range fakePostalAddresses {
+		if !rgx.MatchString(a.zipCode) {
+			t.Errorf("Invalid zip code: %v", a)
+		}
+		if !containsString(prefs, a.prefecture) {
+			t.Errorf("Invalid prefecture: %v", a)
+		}
+		if a.city == "" || a.town == "" {
+			t.Errorf("City and town are required: %v", a)
+		}
+	}
+}
+
+func TestFakePostalAddressesCoverAllPrefectures(t *testing.T) {
+	counts := make(map[string]int)
+	for _, a := range fakePostalAddresses {
+		counts[a.prefecture]++
+	}
+	for _, p := range prefs {
+		if counts[p] < 3 {
+			t.Errorf("Too few postal addresses for %s: %d", p, counts[p])
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package csvutil
+
+import (
+	"io"
+	"math/rand"
+	"strings"
+
+	gimei "github.com/pinzolo/go-gimei"
+	"github.com/pkg/errors"
+)
+
+var supportedAccountTypeFormats = []string{"code", "name"}
+
+// smallKanaMap maps half width small katakana to large one for account holder.
+var smallKanaMap = map[rune]rune{
+	'ｧ': 'ｱ',
+	'ｨ': 'ｲ',
+	'ｩ': 'ｳ',
+	'ｪ': 'ｴ',
+	'ｫ': 'ｵ',
+	'ｬ': 'ﾔ',
+	'ｭ': 'ﾕ',
+	'ｮ': 'ﾖ',
+	'ｯ': 'ﾂ',
+}
+
+// BankOption is option holder for Bank.
+type BankOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// Bank code column symbol.
+	BankCode string
+	// Bank name column symbol.
+	BankName string
+	// Branch code column symbol.
+	BranchCode string
+	// Branch name column symbol.
+	BranchName string
+	// Account type column symbol.
+	AccountType string
+	// Account type format. (code or name, default code)
+	AccountTypeFormat string
+	// Rate of checking account.
+	CheckingRate int
+	// Account number column symbol.
+	AccountNumber string
+	// Account holder column symbol.
+	Holder string
+	// Reference name column symbol for account holder.
+	Reference string
+	// Raise error when reference name is not found.
+	RistrictReference bool
+}
+
+func (o BankOption) symbols() []string {
+	return []string{
+		o.BankCode,
+		o.BankName,
+		o.BranchCode,
+		o.BranchName,
+		o.AccountType,
+		o.AccountNumber,
+		o.Holder,
+	}
+}
+
+func (o *BankOption) validate() error {
+	noCol := true
+	for _, s := range o.symbols() {
+		if s != "" {
+			noCol = false
+		}
+	}
+	if noCol {
+		return errors.New("no column")
+	}
+	if o.NoHeader {
+		for _, s := range append(o.symbols(), o.Reference) {
+			if !isEmptyOrDigit(s) {
+				return errors.New("not number column symbol")
+			}
+		}
+	}
+	if o.AccountTypeFormat == "" {
+		o.AccountTypeFormat = "code"
+	}
+	if o.AccountType != "" && !containsString(supportedAccountTypeFormats, o.AccountTypeFormat) {
+		return errors.Errorf("unsupported account type format: %s", o.AccountTypeFormat)
+	}
+	if o.CheckingRate < 0 || 100 < o.CheckingRate {
+		return errors.New("invalid checking rate (0 <= rate <= 100)")
+	}
+	return nil
+}
+
+func (o BankOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+func (o BankOption) accountType() string {
+	if lot(o.CheckingRate) {
+		if o.AccountTypeFormat == "name" {
+			return "当座"
+		}
+		return "2"
+	}
+	if o.AccountTypeFormat == "name" {
+		return "普通"
+	}
+	return "1"
+}
+
+type bankCols struct {
+	bankCode      *column
+	bankName      *column
+	branchCode    *column
+	branchName    *column
+	accountType   *column
+	accountNumber *column
+	holder        *column
+	reference     *column
+}
+
+func (c *bankCols) err() error {
+	return columns{
+		c.bankCode,
+		c.bankName,
+		c.branchCode,
+		c.branchName,
+		c.accountType,
+		c.accountNumber,
+		c.holder,
+		c.reference,
+	}.err()
+}
+
+func setupBankCols(o BankOption, hdr []string) *bankCols {
+	return &bankCols{
+		bankCode:      newColumnWithIndex(o.BankCode, hdr),
+		bankName:      newColumnWithIndex(o.BankName, hdr),
+		branchCode:    newColumnWithIndex(o.BranchCode, hdr),
+		branchName:    newColumnWithIndex(o.BranchName, hdr),
+		accountType:   newColumnWithIndex(o.AccountType, hdr),
+		accountNumber: newColumnWithIndex(o.AccountNumber, hdr),
+		holder:        newColumnWithIndex(o.Holder, hdr),
+		reference:     newColumnWithIndex(o.Reference, hdr),
+	}
+}
+
+// Bank overwrite values of given columns by dummy bank account.
+func Bank(r io.Reader, w io.Writer, o BankOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	var cols *bankCols
+	csvp := NewCSVProcessor(cr, cw)
+	if o.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			cols = setupBankCols(o, nil)
+			return cols.err()
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			cols = setupBankCols(o, hdr)
+			return hdr, cols.err()
+		})
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		bank := fakeBanks[rand.Intn(len(fakeBanks))]
+		branch := bank.branches[rand.Intn(len(bank.branches))]
+		if cols.bankCode.index != -1 {
+			rec[cols.bankCode.index] = bank.code
+		}
+		if cols.bankName.index != -1 {
+			rec[cols.bankName.index] = bank.name
+		}
+		if cols.branchCode.index != -1 {
+			rec[cols.branchCode.index] = branch.code
+		}
+		if cols.branchName.index != -1 {
+			rec[cols.branchName.index] = branch.name
+		}
+		if cols.accountType.index != -1 {
+			rec[cols.accountType.index] = o.accountType()
+		}
+		if cols.accountNumber.index != -1 {
+			rec[cols.accountNumber.index] = fakeDigits(7)
+		}
+		if cols.holder.index != -1 {
+			var ref string
+			if cols.reference.index != -1 {
+				ref = rec[cols.reference.index]
+			}
+			h, err := fakeAccountHolder(ref)
+			if err != nil && o.RistrictReference {
+				return nil, err
+			}
+			rec[cols.holder.index] = h
+		}
+		return rec, nil
+	})
+
+	return csvp.Process()
+}
+
+// fakeAccountHolder returns account holder name in half width katakana.
+// If reference is kana, it is converted as is.
+// Otherwise, last name of reference is used for dummy name.
+func fakeAccountHolder(ref string) (string, error) {
+	if ref != "" && isKanaName(ref) {
+		return toAccountHolder(ref), nil
+	}
+	name := gimei.NewName()
+	var err error
+	if ref != "" {
+		var n *gimei.Name
+		if name.IsMale() {
+			n, err = gimei.NewMaleByLastName(getReferenceLastName(ref))
+		} else {
+			n, err = gimei.NewFemaleByLastName(getReferenceLastName(ref))
+		}
+		if err == nil {
+			name = n
+		}
+	}
+	return toAccountHolder(name.Last.Katakana() + " " + name.First.Katakana()), err
+}
+
+func toAccountHolder(s string) string {
+	s = strings.Replace(s, "　", " ", -1)
+	rs := []rune(s)
+	for i, r := range rs {
+		rs[i] = hiraganaToKatakana(r)
+	}
+	rs = []rune(toHalfWidthKana(string(rs)))
+	for i, r := range rs {
+		if l, ok := smallKanaMap[r]; ok {
+			rs[i] = l
+		}
+	}
+	return string(rs)
+}
+
+func isKanaName(s string) bool {
+	for _, r := range s {
+		if r == ' ' || r == '　' || r == 'ー' || isHalfWidthKana(r) {
+			continue
+		}
+		if ('ぁ' <= r && r <= 'ゖ') || ('ァ' <= r && r <= 'ヶ') {
+			continue
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,247 @@
+package csvutil
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+)
+
+func TestBankWithoutColumn(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := BankOption{}
+
+	if err := Bank(r, w, o); err == nil {
+		t.Fatal("Bank without column symbol should raise error.")
+	}
+}
+
+func TestBankWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := `1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := BankOption{
+		NoHeader:  true,
+		BankCode:  "0",
+		Reference: "foo",
+	}
+
+	if err := Bank(r, w, o); err == nil {
+		t.Fatal("Bank with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestBankWithUnsupportedAccountTypeFormat(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := BankOption{
+		AccountType:       "aaa",
+		AccountTypeFormat: "foo",
+	}
+
+	if err := Bank(r, w, o); err == nil {
+		t.Fatal("Bank with unsupported account type format should raise error.")
+	}
+}
+
+func TestBankWithInvalidCheckingRate(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	for _, rate := range []int{-1, 101} {
+		r := bytes.NewBufferString(s)
+		w := &bytes.Buffer{}
+		o := BankOption{
+			BankCode:     "aaa",
+			CheckingRate: rate,
+		}
+
+		if err := Bank(r, w, o); err == nil {
+			t.Fatalf("Bank with checking rate %d should raise error.", rate)
+		}
+	}
+}
+
+func TestBankWithColumnNotFound(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := BankOption{
+		BankCode: "ddd",
+	}
+
+	if err := Bank(r, w, o); err == nil {
+		t.Fatal("Bank with not found column should raise error.")
+	}
+}
+
+func TestBankWithoutAccountTypeFormat(t *testing.T) {
+	s := `aaa,bbb
+1,2
+3,4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := BankOption{
+		AccountType: "aaa",
+	}
+
+	if err := Bank(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range readCSV(w.String())[1:] {
+		if rec[0] != "1" {
+			t.Errorf("Account type should be code by default: %s", rec[0])
+		}
+	}
+}
+
+func TestBank(t *testing.T) {
+	s := `銀行コード,銀行名,支店コード,支店名,預金種目,口座番号,口座名義
+1,2,3,4,5,6,7
+1,2,3,4,5,6,7
+1,2,3,4,5,6,7
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := BankOption{
+		BankCode:          "銀行コード",
+		BankName:          "銀行名",
+		BranchCode:        "支店コード",
+		BranchName:        "支店名",
+		AccountType:       "預金種目",
+		AccountTypeFormat: "code",
+		AccountNumber:     "口座番号",
+		Holder:            "口座名義",
+	}
+
+	if err := Bank(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	holderRegex := regexp.MustCompile(`^[ｦ-ﾟ]+ [ｦ-ﾟ]+$`)
+	recs := readCSV(w.String())
+	for _, rec := range recs[1:] {
+		var bank *fakeBankData
+		for i, b := range fakeBanks {
+			if b.code == rec[0] {
+				bank = &fakeBanks[i]
+			}
+		}
+		if bank == nil || bank.name != rec[1] {
+			t.Fatalf("Invalid bank: %v", rec)
+		}
+		found := false
+		for _, br := range bank.branches {
+			if br.code == rec[2] && br.name == rec[3] {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Invalid branch: %v", rec)
+		}
+		if rec[4] != "1" {
+			t.Errorf("Invalid account type: %s", rec[4])
+		}
+		if len(rec[5]) != 7 || !isDigit(rec[5]) {
+			t.Errorf("Invalid account number: %s", rec[5])
+		}
+		if !holderRegex.MatchString(rec[6]) {
+			t.Errorf("Invalid holder: %s", rec[6])
+		}
+	}
+}
+
+func TestBankWithCheckingRate(t *testing.T) {
+	s := `1,2
+3,4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := BankOption{
+		NoHeader:          true,
+		AccountType:       "1",
+		AccountTypeFormat: "name",
+		CheckingRate:      100,
+	}
+
+	if err := Bank(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	expected := `1,当座
+3,当座
+`
+	if a := w.String(); a != expected {
+		t.Errorf("Expected: %s, but got %s", expected, a)
+	}
+}
+
+func TestBankWithKanaReference(t *testing.T) {
+	s := `名前カナ,口座名義
+ヤマダ　ジョウタロウ,
+きっかわ まちこ,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := BankOption{
+		Holder:    "口座名義",
+		Reference: "名前カナ",
+	}
+
+	if err := Bank(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	expected := `名前カナ,口座名義
+ヤマダ　ジョウタロウ,ﾔﾏﾀﾞ ｼﾞﾖｳﾀﾛｳ
+きっかわ まちこ,ｷﾂｶﾜ ﾏﾁｺ
+`
+	if a := w.String(); a != expected {
+		t.Errorf("Expected: %s, but got %s", expected, a)
+	}
+}
+
+func TestBankWithReference(t *testing.T) {
+	s := `名前,口座名義
+山田 太郎,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := BankOption{
+		Holder:    "口座名義",
+		Reference: "名前",
+	}
+
+	if err := Bank(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	if !regexp.MustCompile(`^ﾔﾏﾀﾞ [ｦ-ﾟ]+$`).MatchString(recs[1][1]) {
+		t.Errorf("Holder should start with last name of reference, but got %s", recs[1][1])
+	}
+}
+
+func TestBankWithRistrictReference(t *testing.T) {
+	s := `名前,口座名義
+ｘｘｘ ｙｙｙ,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := BankOption{
+		Holder:            "口座名義",
+		Reference:         "名前",
+		RistrictReference: true,
+	}
+
+	if err := Bank(r, w, o); err == nil {
+		t.Fatal("Bank with not found reference should raise error when ristrict reference.")
+	}
+}
@@ -0,0 +1,328 @@
+package csvutil
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type cardBrand struct {
+	name     string
+	prefixes []string
+	length   int
+	groups   []int
+	cvv      int
+	// testNumbers are test card numbers published by payment services.
+	testNumbers []string
+}
+
+var cardBrands = []cardBrand{
+	{
+		name:     "Visa",
+		prefixes: []string{"4"},
+		length:   16,
+		groups:   []int{4, 4, 4, 4},
+		cvv:      3,
+		testNumbers: []string{
+			"4111111111111111",
+			"4242424242424242",
+			"4012888888881881",
+			"4000056655665556",
+			"4000000000000002",
+			"4000000000000077",
+			"4000000000003220",
+			"4005550000000001",
+			"4444333322221111",
+			"4917610000000000",
+		},
+	},
+	{
+		name:     "Mastercard",
+		prefixes: []string{"51", "52", "53", "54", "55", "2221", "2720"},
+		length:   16,
+		groups:   []int{4, 4, 4, 4},
+		cvv:      3,
+		testNumbers: []string{
+			"5555555555554444",
+			"5105105105105100",
+			"2223003122003222",
+			"5200828282828210",
+			"5454545454545454",
+			"2222400070000005",
+			"5555341244441115",
+		},
+	},
+	{
+		name:     "JCB",
+		prefixes: []string{"3528", "3589"},
+		length:   16,
+		groups:   []int{4, 4, 4, 4},
+		cvv:      3,
+		testNumbers: []string{
+			"3530111333300000",
+			"3566002020360505",
+			"3566111111111113",
+			"3569990010095841",
+		},
+	},
+	{
+		name:     "American Express",
+		prefixes: []string{"34", "37"},
+		length:   15,
+		groups:   []int{4, 6, 5},
+		cvv:      4,
+		testNumbers: []string{
+			"378282246310005",
+			"371449635398431",
+			"378734493671000",
+			"370000000000002",
+			"340000000000009",
+		},
+	},
+	{
+		name:     "Diners Club",
+		prefixes: []string{"36", "300", "305", "38", "39"},
+		length:   14,
+		groups:   []int{4, 6, 4},
+		cvv:      3,
+		testNumbers: []string{
+			"30569309025904",
+			"38520000023237",
+			"36227206271667",
+			"36006666333344",
+			"36700102000000",
+			"36148900647913",
+		},
+	},
+}
+
+// CardOption is option holder for Card.
+type CardOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// Target column symbol.
+	Column string
+	// Column symbol for expiry. (MM/YY)
+	ExpiryColumn string
+	// Column symbol for CVV.
+	CVVColumn string
+	// Column symbol for brand name.
+	BrandColumn string
+	// Rate of Visa.
+	VisaRate int
+	// Rate of Mastercard.
+	MastercardRate int
+	// Rate of JCB.
+	JCBRate int
+	// Rate of American Express.
+	AmexRate int
+	// Rate of Diners Club.
+	DinersRate int
+	// Separate number into groups by hyphen.
+	Hyphen bool
+	// Mask digits except first 4 and last 4 digits.
+	Mask bool
+	// Output published test card numbers instead of random numbers.
+	TestNumber bool
+}
+
+func (o CardOption) validate() error {
+	if o.Column == "" {
+		return errors.New("no column")
+	}
+	if o.NoHeader {
+		for _, c := range []string{o.Column, o.ExpiryColumn, o.CVVColumn, o.BrandColumn} {
+			if !isEmptyOrDigit(c) {
+				return errors.New("not number column symbol")
+			}
+		}
+	}
+	total := 0
+	for _, r := range o.rates() {
+		if r < 0 || 100 < r {
+			return errors.New("invalid brand rate (0 <= rate <= 100)")
+		}
+		total += r
+	}
+	if total != 0 && total != 100 {
+		return errors.New("total of brand rates should be 100")
+	}
+	return nil
+}
+
+func (o CardOption) rates() []int {
+	return []int{o.VisaRate, o.MastercardRate, o.JCBRate, o.AmexRate, o.DinersRate}
+}
+
+func (o CardOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Card overwrite value of given column by dummy credit card number.
+func Card(r io.Reader, w io.Writer, o CardOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	var col, expCol, cvvCol, brandCol *column
+	setup := func(hdr []string) error {
+		col = newColumnWithIndex(o.Column, hdr)
+		expCol = newColumnWithIndex(o.ExpiryColumn, hdr)
+		cvvCol = newColumnWithIndex(o.CVVColumn, hdr)
+		brandCol = newColumnWithIndex(o.BrandColumn, hdr)
+		return columns{col, expCol, cvvCol, brandCol}.err()
+	}
+	csvp := NewCSVProcessor(cr, cw)
+	if o.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			return setup(nil)
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			return hdr, setup(hdr)
+		})
+	}
+
+	names := make([]string, len(cardBrands))
+	for i, b := range cardBrands {
+		names[i] = b.name
+	}
+	var weights []int
+	for _, r := range o.rates() {
+		if r != 0 {
+			weights = o.rates()
+			break
+		}
+	}
+	c := newChooser(names, weights)
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		b := findCardBrand(c.choose())
+		rec[col.index] = o.format(b, fakeCardNumber(b, o.TestNumber))
+		if expCol.index != -1 {
+			rec[expCol.index] = fakeCardExpiry(time.Now())
+		}
+		if cvvCol.index != -1 {
+			rec[cvvCol.index] = fakeDigits(b.cvv)
+		}
+		if brandCol.index != -1 {
+			rec[brandCol.index] = b.name
+		}
+		return rec, nil
+	})
+
+	return csvp.Process()
+}
+
+func findCardBrand(name string) cardBrand {
+	for _, b := range cardBrands {
+		if b.name == name {
+			return b
+		}
+	}
+	return cardBrands[0]
+}
+
+func (o CardOption) format(b cardBrand, num string) string {
+	if o.Mask {
+		num = num[:4] + strings.Repeat("*", len(num)-8) + num[len(num)-4:]
+	}
+	if !o.Hyphen {
+		return num
+	}
+	parts := make([]string, 0, len(b.groups))
+	i := 0
+	for _, g := range b.groups {
+		parts = append(parts, num[i:i+g])
+		i += g
+	}
+	return strings.Join(parts, "-")
+}
+
+func fakeCardNumber(b cardBrand, test bool) string {
+	if test {
+		return sampleString(b.testNumbers)
+	}
+	for {
+		p := fakeCardPrefix(b)
+		body := p + fakeDigits(b.length-len(p)-1)
+		num := body + strconv.Itoa(luhnCheckDigit(body))
+		if !containsString(b.testNumbers, num) {
+			return num
+		}
+	}
+}
+
+// fakeCardPrefix returns prefix of brand.
+// Pair of 4 digits prefixes (e.g. 3528 and 3589) is treated as range.
+func fakeCardPrefix(b cardBrand) string {
+	p := sampleString(b.prefixes)
+	if len(p) == 4 {
+		var ps []string
+		for _, bp := range b.prefixes {
+			if len(bp) == 4 {
+				ps = append(ps, bp)
+			}
+		}
+		min, _ := strconv.Atoi(ps[0])
+		max, _ := strconv.Atoi(ps[len(ps)-1])
+		return strconv.Itoa(rand.Intn(max-min+1) + min)
+	}
+	if len(p) == 3 {
+		// 300-305
+		n, _ := strconv.Atoi(p)
+		return strconv.Itoa(n - n%10 + rand.Intn(6))
+	}
+	return p
+}
+
+func fakeDigits(n int) string {
+	bs := make([]byte, n)
+	for i := range bs {
+		bs[i] = byte('0' + rand.Intn(10))
+	}
+	return string(bs)
+}
+
+func fakeCardExpiry(now time.Time) string {
+	t := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, rand.Intn(60)+1, 0)
+	return fmt.Sprintf("%02d/%02d", t.Month(), t.Year()%100)
+}
+
+// luhnCheckDigit returns check digit of Luhn algorithm for given digits.
+func luhnCheckDigit(s string) int {
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		d := int(s[len(s)-1-i] - '0')
+		if i%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return (10 - sum%10) % 10
+}
+
+func isValidLuhn(s string) bool {
+	if len(s) < 2 || !isDigit(s) {
+		return false
+	}
+	return luhnCheckDigit(s[:len(s)-1]) == int(s[len(s)-1]-'0')
+}
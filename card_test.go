@@ -0,0 +1,283 @@
+package csvutil
+
+import (
+	"bytes"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func BenchmarkCard(b *testing.B) {
+	p, err := ioutil.ReadFile("testdata/bench.csv")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < b.N; i++ {
+		r := bytes.NewBuffer(p)
+		w := &bytes.Buffer{}
+		o := CardOption{
+			Column: "電話番号",
+		}
+		Card(r, w, o)
+	}
+}
+
+func TestCardWithoutColumn(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CardOption{}
+
+	if err := Card(r, w, o); err == nil {
+		t.Fatal("Card without column symbol should raise error.")
+	}
+}
+
+func TestCardWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := `1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CardOption{
+		NoHeader:  true,
+		Column:    "0",
+		CVVColumn: "foo",
+	}
+
+	if err := Card(r, w, o); err == nil {
+		t.Fatal("Card with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestCardWithInvalidRate(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	for _, o := range []CardOption{
+		{Column: "aaa", VisaRate: -1, JCBRate: 101},
+		{Column: "aaa", VisaRate: 50, JCBRate: 40},
+		{Column: "aaa", VisaRate: 60, JCBRate: 60},
+	} {
+		r := bytes.NewBufferString(s)
+		w := &bytes.Buffer{}
+		if err := Card(r, w, o); err == nil {
+			t.Errorf("Card with invalid rates should raise error. %+v", o)
+		}
+	}
+}
+
+func TestCardWithColumnNotFound(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CardOption{
+		Column:       "aaa",
+		ExpiryColumn: "ddd",
+	}
+
+	if err := Card(r, w, o); err == nil {
+		t.Fatal("Card with not found column should raise error.")
+	}
+}
+
+func TestCard(t *testing.T) {
+	s := `aaa,bbb,ccc,ddd
+1,2,3,4
+4,5,6,7
+7,8,9,10
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CardOption{
+		Column:       "aaa",
+		ExpiryColumn: "bbb",
+		CVVColumn:    "ccc",
+		BrandColumn:  "ddd",
+	}
+
+	if err := Card(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	expRegex := regexp.MustCompile(`^(0[1-9]|1[0-2])/\d\d$`)
+	for _, rec := range recs[1:] {
+		b := findCardBrand(rec[3])
+		if b.name != rec[3] {
+			t.Errorf("Unknown brand: %s", rec[3])
+		}
+		if len(rec[0]) != b.length || !isValidLuhn(rec[0]) {
+			t.Errorf("Invalid card number: %s (%s)", rec[0], rec[3])
+		}
+		if !expRegex.MatchString(rec[1]) {
+			t.Errorf("Invalid expiry: %s", rec[1])
+		}
+		if len(rec[2]) != b.cvv || !isDigit(rec[2]) {
+			t.Errorf("Invalid CVV: %s", rec[2])
+		}
+	}
+}
+
+func TestCardWithNoHeader(t *testing.T) {
+	s := `1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CardOption{
+		NoHeader: true,
+		Column:   "1",
+		JCBRate:  100,
+	}
+
+	if err := Card(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	for _, rec := range recs {
+		if !strings.HasPrefix(rec[1], "35") || !isValidLuhn(rec[1]) {
+			t.Errorf("Invalid JCB number: %s", rec[1])
+		}
+	}
+}
+
+func TestCardWithRate(t *testing.T) {
+	s := "aaa\n" + strings.Repeat("1\n", 100)
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CardOption{
+		Column:   "aaa",
+		AmexRate: 100,
+		Hyphen:   true,
+	}
+
+	if err := Card(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	re := regexp.MustCompile(`^3[47]\d\d-\d{6}-\d{5}$`)
+	for _, rec := range recs[1:] {
+		if !re.MatchString(rec[0]) {
+			t.Errorf("Invalid American Express number: %s", rec[0])
+		}
+	}
+}
+
+func TestCardWithMask(t *testing.T) {
+	s := `aaa
+1
+2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CardOption{
+		Column:   "aaa",
+		VisaRate: 100,
+		Hyphen:   true,
+		Mask:     true,
+	}
+
+	if err := Card(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	re := regexp.MustCompile(`^4\d{3}-\*{4}-\*{4}-\d{4}$`)
+	for _, rec := range recs[1:] {
+		if !re.MatchString(rec[0]) {
+			t.Errorf("Invalid masked number: %s", rec[0])
+		}
+	}
+}
+
+func TestCardWithTestNumber(t *testing.T) {
+	s := `aaa
+1
+2
+3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CardOption{
+		Column:     "aaa",
+		TestNumber: true,
+	}
+
+	if err := Card(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	for _, rec := range recs[1:] {
+		found := false
+		for _, b := range cardBrands {
+			if containsString(b.testNumbers, rec[0]) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s is not test number", rec[0])
+		}
+	}
+}
+
+func TestCardTestNumbersAreValid(t *testing.T) {
+	for _, b := range cardBrands {
+		for _, n := range b.testNumbers {
+			if len(n) != b.length || !isValidLuhn(n) {
+				t.Errorf("Invalid test number: %s (%s)", n, b.name)
+			}
+		}
+	}
+}
+
+func TestFakeCardNumberDoesNotReturnTestNumber(t *testing.T) {
+	for _, b := range cardBrands {
+		for i := 0; i < 1000; i++ {
+			n := fakeCardNumber(b, false)
+			if containsString(b.testNumbers, n) {
+				t.Fatalf("Test number generated: %s", n)
+			}
+		}
+	}
+}
+
+func TestFakeCardPrefix(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		p := fakeCardPrefix(cardBrands[1])
+		if !(("51" <= p && p <= "55") || ("2221" <= p && p <= "2720")) {
+			t.Fatalf("Invalid Mastercard prefix: %s", p)
+		}
+		p = fakeCardPrefix(cardBrands[4])
+		if !containsString([]string{"36", "38", "39", "300", "301", "302", "303", "304", "305"}, p) {
+			t.Fatalf("Invalid Diners Club prefix: %s", p)
+		}
+	}
+}
+
+func TestFakeCardExpiry(t *testing.T) {
+	now := time.Date(2018, 12, 15, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 100; i++ {
+		e := fakeCardExpiry(now)
+		if e[3:] < "19" || "23" < e[3:] {
+			t.Fatalf("Expiry should be future: %s", e)
+		}
+	}
+}
+
+func TestLuhnCheckDigit(t *testing.T) {
+	if d := luhnCheckDigit("411111111111111"); d != 1 {
+		t.Errorf("Expected 1 but %d", d)
+	}
+	if d := luhnCheckDigit("37828224631000"); d != 5 {
+		t.Errorf("Expected 5 but %d", d)
+	}
+	if isValidLuhn("4111111111111112") {
+		t.Error("4111111111111112 should be invalid")
+	}
+}
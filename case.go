@@ -0,0 +1,113 @@
+package csvutil
+
+import (
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+var supportedCases = []string{"upper", "lower", "title"}
+
+// CaseOption is option holder for Case.
+type CaseOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// ColumnSyms header or column index list. (default all columns)
+	ColumnSyms []string
+	// To is letter case after changing. (upper, lower or title)
+	To string
+}
+
+func (o CaseOption) validate() error {
+	if o.NoHeader {
+		for _, c := range o.ColumnSyms {
+			if !isDigit(c) {
+				return errors.New("not number column symbol")
+			}
+		}
+	}
+	if o.To == "" {
+		return errors.New("no case")
+	}
+	if !containsString(supportedCases, o.To) {
+		return errors.Errorf("unsupported case: %s", o.To)
+	}
+	return nil
+}
+
+func (o CaseOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Case changes letter case of given columns.
+func Case(r io.Reader, w io.Writer, o CaseOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	var cols columns
+	csvp := NewCSVProcessor(cr, cw)
+	if o.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			cols = newUniqueColumns(o.ColumnSyms, nil)
+			return cols.err()
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			cols = newUniqueColumns(o.ColumnSyms, hdr)
+			return hdr, cols.err()
+		})
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		for i := range rec {
+			if len(cols) == 0 || containsColumnIndex(cols, i) {
+				rec[i] = o.change(rec[i])
+			}
+		}
+		return rec, nil
+	})
+
+	return csvp.Process()
+}
+
+func (o CaseOption) change(s string) string {
+	switch o.To {
+	case "upper":
+		return strings.ToUpper(s)
+	case "lower":
+		return strings.ToLower(s)
+	}
+	return toTitle(s)
+}
+
+// toTitle makes first letter of each word upper case and others lower case.
+func toTitle(s string) string {
+	rs := []rune(s)
+	head := true
+	for i, r := range rs {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'' {
+			if head {
+				rs[i] = unicode.ToTitle(r)
+			} else {
+				rs[i] = unicode.ToLower(r)
+			}
+			head = false
+		} else {
+			head = true
+		}
+	}
+	return string(rs)
+}
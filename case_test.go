@@ -0,0 +1,131 @@
+package csvutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCaseWithoutTo(t *testing.T) {
+	s := `aaa,bbb
+a,b
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CaseOption{}
+
+	if err := Case(r, w, o); err == nil {
+		t.Fatal("Case without case should raise error.")
+	}
+}
+
+func TestCaseWithUnsupportedTo(t *testing.T) {
+	s := `aaa,bbb
+a,b
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CaseOption{
+		To: "camel",
+	}
+
+	if err := Case(r, w, o); err == nil {
+		t.Fatal("Case with unsupported case should raise error.")
+	}
+}
+
+func TestCaseWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := `a,b
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CaseOption{
+		NoHeader:   true,
+		ColumnSyms: []string{"aaa"},
+		To:         "upper",
+	}
+
+	if err := Case(r, w, o); err == nil {
+		t.Fatal("Case with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestCaseWithUnknownColumn(t *testing.T) {
+	s := `aaa,bbb
+a,b
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CaseOption{
+		ColumnSyms: []string{"ccc"},
+		To:         "upper",
+	}
+
+	if err := Case(r, w, o); err == nil {
+		t.Fatal("Case with unknown column should raise error.")
+	}
+}
+
+func TestCase(t *testing.T) {
+	s := `aaa,bbb
+foo bar,Ｆｏｏ
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CaseOption{
+		To: "upper",
+	}
+
+	if err := Case(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb
+FOO BAR,ＦＯＯ
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestCaseWithColumns(t *testing.T) {
+	s := `aaa,bbb
+FOO,BAR
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CaseOption{
+		ColumnSyms: []string{"bbb"},
+		To:         "lower",
+	}
+
+	if err := Case(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb
+FOO,bar
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestCaseValue(t *testing.T) {
+	tests := []struct {
+		to       string
+		src      string
+		expected string
+	}{
+		{"upper", "taro yamada", "TARO YAMADA"},
+		{"lower", "Taro YAMADA", "taro yamada"},
+		{"title", "TARO YAMADA", "Taro Yamada"},
+		{"title", "mary-jane o'neil", "Mary-Jane O'neil"},
+		{"title", "山田 taro", "山田 Taro"},
+	}
+	for _, tt := range tests {
+		o := CaseOption{To: tt.to}
+		if actual := o.change(tt.src); actual != tt.expected {
+			t.Errorf("Expected: %s, but got %s", tt.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package csvutil
+
+import (
+	"io"
+	"math/rand"
+
+	"github.com/pkg/errors"
+)
+
+// ChoiceOption is option holder for Choice.
+type ChoiceOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// Target column symbol.
+	Column string
+	// Values for choice.
+	Values []string
+	// Weights are percentages of each value. Negative weight means unspecified.
+	// Rest of percentage is divided equally into unspecified values.
+	// When all weights are specified and total is less than 100, empty value is chosen for the rest.
+	Weights []int
+	// Choose each value only once.
+	NoRepeat bool
+	// Column symbol of values source CSV. Distinct values of this column are used as values.
+	SourceColumn string
+	// Encoding of values source CSV. (default same as Encoding)
+	SourceEncoding string
+}
+
+func (o ChoiceOption) validate() error {
+	if o.Column == "" {
+		return errors.New("no column")
+	}
+	if o.NoHeader {
+		if !isDigit(o.Column) {
+			return errors.New("not number column symbol")
+		}
+	}
+	if len(o.Values) == 0 && o.SourceColumn == "" {
+		return errors.New("no values")
+	}
+	if len(o.Values) != 0 && o.SourceColumn != "" {
+		return errors.New("values and source column cannot be used together")
+	}
+	if o.Weights != nil && len(o.Weights) != len(o.Values) {
+		return errors.New("count of weights does not match count of values")
+	}
+	total := 0
+	for _, w := range o.Weights {
+		if w > 100 {
+			return errors.Errorf("invalid weight: %d", w)
+		}
+		if w > 0 {
+			total += w
+		}
+	}
+	if total > 100 {
+		return errors.New("total of weights is greater than 100")
+	}
+	return nil
+}
+
+func (o ChoiceOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+func (o ChoiceOption) sourceEncoding() string {
+	if o.SourceEncoding != "" {
+		return o.SourceEncoding
+	}
+	return o.Encoding
+}
+
+// Choice overwrite value of given column by value chosen from values.
+// When SourceColumn is given, values are read from src that should have header line.
+func Choice(r io.Reader, w io.Writer, src io.Reader, o ChoiceOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	vals := o.Values
+	if o.SourceColumn != "" {
+		if src == nil {
+			return errors.New("no source")
+		}
+		var err error
+		if vals, err = distinctValues(src, o.SourceColumn, o.sourceEncoding()); err != nil {
+			return err
+		}
+	}
+	c := newChooser(vals, o.Weights)
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	var col *column
+	csvp := NewCSVProcessor(cr, cw)
+	if o.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			col = newColumnWithIndex(o.Column, nil)
+			return col.err
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			col = newColumnWithIndex(o.Column, hdr)
+			return hdr, col.err
+		})
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		if o.NoRepeat {
+			s, ok := c.draw()
+			if !ok {
+				return nil, errors.New("values are exhausted")
+			}
+			rec[col.index] = s
+		} else {
+			rec[col.index] = c.choose()
+		}
+		return rec, nil
+	})
+
+	return csvp.Process()
+}
+
+// distinctValues returns distinct values of given column in order of appearance.
+func distinctValues(src io.Reader, sym string, enc string) ([]string, error) {
+	cr, _ := reader(src, enc)
+	hdr, err := cr.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read source header")
+	}
+	col := newColumnWithIndex(sym, hdr)
+	if col.err != nil {
+		return nil, col.err
+	}
+
+	var vals []string
+	seen := make(map[string]struct{})
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot read source")
+		}
+		v := rec[col.index]
+		if _, ok := seen[v]; ok || v == "" {
+			continue
+		}
+		seen[v] = struct{}{}
+		vals = append(vals, v)
+	}
+	if len(vals) == 0 {
+		return nil, errors.New("no values in source")
+	}
+	return vals, nil
+}
+
+type chooser struct {
+	values []string
+	shares []float64
+	// rest is share for empty value.
+	rest float64
+}
+
+func newChooser(vals []string, weights []int) *chooser {
+	total := 0
+	unspecified := 0
+	for i := range vals {
+		if weights == nil || weights[i] < 0 {
+			unspecified++
+		} else {
+			total += weights[i]
+		}
+	}
+	c := &chooser{
+		values: vals,
+		shares: make([]float64, len(vals)),
+	}
+	for i := range vals {
+		if weights == nil || weights[i] < 0 {
+			c.shares[i] = float64(100-total) / float64(unspecified)
+		} else {
+			c.shares[i] = float64(weights[i])
+		}
+	}
+	if unspecified == 0 {
+		c.rest = float64(100 - total)
+	}
+	return c
+}
+
+// choose returns value chosen by weights.
+// If no value is chosen by floating-point error of shares, returns last value.
+func (c *chooser) choose() string {
+	n := rand.Float64() * 100
+	for i, s := range c.shares {
+		if n < s {
+			return c.values[i]
+		}
+		n -= s
+	}
+	if n < c.rest || len(c.values) == 0 {
+		return ""
+	}
+	return c.values[len(c.values)-1]
+}
+
+// draw returns value chosen by weights, and removes it from candidates.
+func (c *chooser) draw() (string, bool) {
+	if len(c.values) == 0 {
+		return "", false
+	}
+	total := 0.0
+	for _, s := range c.shares {
+		total += s
+	}
+	i := len(c.values) - 1
+	if total > 0 {
+		n := rand.Float64() * total
+		for j, s := range c.shares {
+			if n < s {
+				i = j
+				break
+			}
+			n -= s
+		}
+	} else {
+		i = rand.Intn(len(c.values))
+	}
+	v := c.values[i]
+	c.values = append(c.values[:i:i], c.values[i+1:]...)
+	c.shares = append(c.shares[:i:i], c.shares[i+1:]...)
+	return v, true
+}
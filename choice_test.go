@@ -0,0 +1,296 @@
+package csvutil
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+)
+
+func TestChoiceWithoutColumn(t *testing.T) {
+	s := `id,status
+1,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := ChoiceOption{
+		Values: []string{"a"},
+	}
+
+	if err := Choice(r, w, nil, o); err == nil {
+		t.Fatal("Choice without column should raise error.")
+	}
+}
+
+func TestChoiceWithNoHeaderButColumnNotNumber(t *testing.T) {
+	r := bytes.NewBufferString("1,\n")
+	w := &bytes.Buffer{}
+	o := ChoiceOption{
+		NoHeader: true,
+		Column:   "status",
+		Values:   []string{"a"},
+	}
+
+	if err := Choice(r, w, nil, o); err == nil {
+		t.Fatal("Choice with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestChoiceWithoutValues(t *testing.T) {
+	s := `id,status
+1,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := ChoiceOption{
+		Column: "status",
+	}
+
+	if err := Choice(r, w, nil, o); err == nil {
+		t.Fatal("Choice without values should raise error.")
+	}
+}
+
+func TestChoiceWithValuesAndSourceColumn(t *testing.T) {
+	s := `id,status
+1,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := ChoiceOption{
+		Column:       "status",
+		Values:       []string{"a"},
+		SourceColumn: "status",
+	}
+
+	if err := Choice(r, w, nil, o); err == nil {
+		t.Fatal("Choice with values and source column should raise error.")
+	}
+}
+
+func TestChoiceWithInvalidWeights(t *testing.T) {
+	tests := [][]int{
+		{50},
+		{101, -1},
+		{60, 50},
+	}
+	for _, weights := range tests {
+		s := `id,status
+1,
+`
+		r := bytes.NewBufferString(s)
+		w := &bytes.Buffer{}
+		o := ChoiceOption{
+			Column:  "status",
+			Values:  []string{"a", "b"},
+			Weights: weights,
+		}
+
+		if err := Choice(r, w, nil, o); err == nil {
+			t.Errorf("Choice with weights %v should raise error.", weights)
+		}
+	}
+}
+
+func TestChoice(t *testing.T) {
+	r := bytes.NewBufferString(generateCSV("id,status", 1000, func(int) string { return "1," }))
+	w := &bytes.Buffer{}
+	o := ChoiceOption{
+		Column:  "status",
+		Values:  []string{"active", "suspended", "deleted"},
+		Weights: []int{70, 20, 10},
+	}
+
+	if err := Choice(r, w, nil, o); err != nil {
+		t.Fatal(err)
+	}
+
+	counts := make(map[string]int)
+	for _, rec := range readCSV(w.String())[1:] {
+		counts[rec[1]]++
+	}
+	if len(counts) != 3 {
+		t.Fatalf("Unexpected values: %v", counts)
+	}
+	if counts["active"] < 600 || counts["active"] > 800 {
+		t.Errorf("active should be chosen about 70%%, but got %d", counts["active"])
+	}
+	if counts["deleted"] > 200 {
+		t.Errorf("deleted should be chosen about 10%%, but got %d", counts["deleted"])
+	}
+}
+
+func TestChoiceWithPartialWeights(t *testing.T) {
+	r := bytes.NewBufferString(generateCSV("id,status", 100, func(int) string { return "1," }))
+	w := &bytes.Buffer{}
+	o := ChoiceOption{
+		Column:  "status",
+		Values:  []string{"a", "b", "c"},
+		Weights: []int{100, -1, -1},
+	}
+
+	if err := Choice(r, w, nil, o); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rec := range readCSV(w.String())[1:] {
+		if rec[1] != "a" {
+			t.Fatalf("Expected a, but got %s", rec[1])
+		}
+	}
+}
+
+func TestChoiceWithRest(t *testing.T) {
+	r := bytes.NewBufferString(generateCSV("id,status", 100, func(int) string { return "1," }))
+	w := &bytes.Buffer{}
+	o := ChoiceOption{
+		Column:  "status",
+		Values:  []string{"a"},
+		Weights: []int{0},
+	}
+
+	if err := Choice(r, w, nil, o); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rec := range readCSV(w.String())[1:] {
+		if rec[1] != "" {
+			t.Fatalf("Expected empty, but got %s", rec[1])
+		}
+	}
+}
+
+func TestChoiceWithNoRepeat(t *testing.T) {
+	s := `id,status
+1,
+1,
+1,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := ChoiceOption{
+		Column:   "status",
+		Values:   []string{"a", "b", "c"},
+		Weights:  []int{80, 10, 10},
+		NoRepeat: true,
+	}
+
+	if err := Choice(r, w, nil, o); err != nil {
+		t.Fatal(err)
+	}
+
+	var vals []string
+	for _, rec := range readCSV(w.String())[1:] {
+		vals = append(vals, rec[1])
+	}
+	sort.Strings(vals)
+	if vals[0] != "a" || vals[1] != "b" || vals[2] != "c" {
+		t.Fatalf("Each value should be chosen only once, but got %v", vals)
+	}
+}
+
+func TestChoiceWithNoRepeatAndExhausted(t *testing.T) {
+	s := `id,status
+1,
+1,
+1,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := ChoiceOption{
+		Column:   "status",
+		Values:   []string{"a", "b"},
+		NoRepeat: true,
+	}
+
+	if err := Choice(r, w, nil, o); err == nil {
+		t.Fatal("Choice with no repeat should raise error when values are exhausted.")
+	}
+}
+
+func TestChoiceWithSourceColumn(t *testing.T) {
+	s := `id,status
+1,
+1,
+`
+	r := bytes.NewBufferString(s)
+	src := bytes.NewBufferString(`name,dept
+a,営業部
+b,
+c,営業部
+d,開発部
+`)
+	w := &bytes.Buffer{}
+	o := ChoiceOption{
+		Column:       "status",
+		SourceColumn: "dept",
+		NoRepeat:     true,
+	}
+
+	if err := Choice(r, w, src, o); err != nil {
+		t.Fatal(err)
+	}
+
+	var vals []string
+	for _, rec := range readCSV(w.String())[1:] {
+		vals = append(vals, rec[1])
+	}
+	sort.Strings(vals)
+	if vals[0] != "営業部" || vals[1] != "開発部" {
+		t.Fatalf("Distinct values of source column should be chosen, but got %v", vals)
+	}
+}
+
+func TestChoiceWithUnknownSourceColumn(t *testing.T) {
+	s := `id,status
+1,
+1,
+`
+	r := bytes.NewBufferString(s)
+	src := bytes.NewBufferString("name,dept\na,b\n")
+	w := &bytes.Buffer{}
+	o := ChoiceOption{
+		Column:       "status",
+		SourceColumn: "foo",
+	}
+
+	if err := Choice(r, w, src, o); err == nil {
+		t.Fatal("Choice with unknown source column should raise error.")
+	}
+}
+
+func TestChoiceWithSourceColumnWithoutSource(t *testing.T) {
+	s := `id,status
+1,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := ChoiceOption{
+		Column:       "status",
+		SourceColumn: "dept",
+	}
+
+	if err := Choice(r, w, nil, o); err == nil {
+		t.Fatal("Choice with source column but without source should raise error.")
+	}
+}
+
+func TestChooserWithShortShares(t *testing.T) {
+	c := &chooser{
+		values: []string{"a", "b", "c"},
+		shares: []float64{1, 1, 1},
+	}
+	for i := 0; i < 100; i++ {
+		if v := c.choose(); v == "" {
+			t.Fatal("Chooser should return value even if shares are short of 100.")
+		}
+	}
+}
+
+func TestChooserWithImplicitShares(t *testing.T) {
+	c := newChooser([]string{"a", "b", "c", "d", "e", "f", "g"}, nil)
+	for i := 0; i < 10000; i++ {
+		if v := c.choose(); v == "" {
+			t.Fatal("Chooser without weights should not return empty value.")
+		}
+	}
+}
@@ -12,6 +12,7 @@ var cmdAddress = &Command{
         指定した列にダミーの住所を出力します。
         郵便番号、都道府県、都市、町は同じ列を指定すれば追記されます。
         都市と町の区分は厳格なものではなく、都市→町→番地の順に記載されるもの程度の扱いです。
+        同じ行の郵便番号、都道府県、都市、町は同梱の郵便番号データから選択された1つの住所から出力されるため、整合性が保たれます。
 
 ARGUMENTS
         FILE
@@ -69,6 +70,10 @@ OPTIONS
         -nw, --number-width NUMBER
             このオプションに 1 を渡すと半角で、2 を渡すと全角で番地を出力します。
             初期値は 1 です。
+
+        -r, --random
+            郵便番号、都道府県、都市、町の整合性を保たず、列ごとにランダムな値を出力します。
+            同梱の郵便番号データに含まれない住所も出力されますが、郵便番号はランダムな数字となります。
 	`,
 }
 
@@ -105,6 +110,8 @@ func init() {
 	cmdAddress.Flag.BoolVar(&addressOpt.BlockNumber, "bn", false, "Output block number after town")
 	cmdAddress.Flag.IntVar(&addressOpt.NumberWidth, "number-width", 1, "Block number character width")
 	cmdAddress.Flag.IntVar(&addressOpt.NumberWidth, "nw", 1, "Block number character width")
+	cmdAddress.Flag.BoolVar(&addressOpt.Random, "random", false, "Output fully random address")
+	cmdAddress.Flag.BoolVar(&addressOpt.Random, "r", false, "Output fully random address")
 }
 
 // runAddress executes address command and return exit code.
@@ -13,6 +13,16 @@ func Test_runAddress(t *testing.T) {
 	addressOpt.ZipCode = ""
 }
 
+func Test_runAddressOnRandom(t *testing.T) {
+	addressOpt.ZipCode = "0"
+	addressOpt.Random = true
+	if c := runAddress([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	addressOpt.Random = false
+	addressOpt.ZipCode = ""
+}
+
 func Test_runAddressOnNoFile(t *testing.T) {
 	if c := runAddress([]string{testFilePath("no-file.csv")}); c == 0 {
 		t.Fatalf("Invalid failed exit code: %d", c)
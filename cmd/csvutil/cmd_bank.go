@@ -0,0 +1,159 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdBank = &Command{
+	Run:       runBank,
+	UsageLine: "bank [OPTIONS...] [FILE]",
+	Short:     "銀行口座生成",
+	Long: `DESCRIPTION
+        指定した列にダミーの銀行口座を出力します。
+        金融機関コード・支店コード・金融機関名・支店名は同梱の一覧から選択されるため、同じ行では整合性が保たれます。
+        同梱の支店一覧はサンプルのため、実在の支店とは一致しない場合があります。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -bc, --bank-code COLUMN_SYMBOL
+            金融機関コード（4桁）を出力する列のシンボルを指定します。
+
+        -bn, --bank-name COLUMN_SYMBOL
+            金融機関名を出力する列のシンボルを指定します。
+
+        -brc, --branch-code COLUMN_SYMBOL
+            支店コード（3桁）を出力する列のシンボルを指定します。
+
+        -brn, --branch-name COLUMN_SYMBOL
+            支店名を出力する列のシンボルを指定します。
+
+        -at, --account-type COLUMN_SYMBOL
+            預金種目を出力する列のシンボルを指定します。
+
+        -atf, --account-type-format FORMAT
+            預金種目を出力するフォーマットを指定します。初期値は code です。
+                 FORMAT | 普通 | 当座
+                ------- | ---- | ----
+                 code   | 1    | 2
+                 name   | 普通 | 当座
+
+        -cr, --checking-rate PERCENTAGE
+            当座預金を出力する割合を指定します。0〜100までの整数を指定して下さい。（初期値: 0）
+
+        -an, --account-number COLUMN_SYMBOL
+            口座番号（7桁）を出力する列のシンボルを指定します。
+
+        -ho, --holder COLUMN_SYMBOL
+            口座名義を出力する列のシンボルを指定します。
+            口座名義は半角カタカナで出力され、姓と名の間は半角スペースで区切られます。
+            小書き文字（ｬ,ｭ,ｮ,ｯ など）は大文字で出力されます。
+
+        -r, --reference COLUMN_SYMBOL
+            口座名義を生成するために参照する列のシンボルを指定します。
+            列の値がひらがな・カタカナのみの場合、その値をそのまま口座名義に変換します。
+            それ以外の場合、姓と名の間は半角スペースか全角スペースで区切られている必要があり、姓を引き継いだ名義を出力します。
+            スペースで区切られていない場合、列の値全体が姓として扱われます。
+
+        -rr, --ristrict-reference
+            --reference オプションで参照した値から口座名義を生成できなかった場合、エラーを起こします。
+            指定しない場合、口座名義を生成できなかった場合にはランダムな名義が出力されます。
+	`,
+}
+
+type cmdBankOption struct {
+	csvutil.BankOption
+	Overwrite bool
+	Backup    bool
+}
+
+var bankOpt = cmdBankOption{}
+
+func init() {
+	cmdBank.Flag.BoolVar(&bankOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdBank.Flag.BoolVar(&bankOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdBank.Flag.BoolVar(&bankOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdBank.Flag.BoolVar(&bankOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdBank.Flag.BoolVar(&bankOpt.Backup, "backup", false, "Backup source file.")
+	cmdBank.Flag.BoolVar(&bankOpt.Backup, "b", false, "Backup source file.")
+	cmdBank.Flag.StringVar(&bankOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdBank.Flag.StringVar(&bankOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdBank.Flag.StringVar(&bankOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdBank.Flag.StringVar(&bankOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdBank.Flag.StringVar(&bankOpt.BankCode, "bank-code", "", "Bank code column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.BankCode, "bc", "", "Bank code column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.BankName, "bank-name", "", "Bank name column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.BankName, "bn", "", "Bank name column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.BranchCode, "branch-code", "", "Branch code column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.BranchCode, "brc", "", "Branch code column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.BranchName, "branch-name", "", "Branch name column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.BranchName, "brn", "", "Branch name column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.AccountType, "account-type", "", "Account type column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.AccountType, "at", "", "Account type column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.AccountTypeFormat, "account-type-format", "code", "Account type format")
+	cmdBank.Flag.StringVar(&bankOpt.AccountTypeFormat, "atf", "code", "Account type format")
+	cmdBank.Flag.IntVar(&bankOpt.CheckingRate, "checking-rate", 0, "Checking account rate")
+	cmdBank.Flag.IntVar(&bankOpt.CheckingRate, "cr", 0, "Checking account rate")
+	cmdBank.Flag.StringVar(&bankOpt.AccountNumber, "account-number", "", "Account number column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.AccountNumber, "an", "", "Account number column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.Holder, "holder", "", "Account holder column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.Holder, "ho", "", "Account holder column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.Reference, "reference", "", "Reference column symbol")
+	cmdBank.Flag.StringVar(&bankOpt.Reference, "r", "", "Reference column symbol")
+	cmdBank.Flag.BoolVar(&bankOpt.RistrictReference, "ristrict-reference", false, "Raise error reference not found")
+	cmdBank.Flag.BoolVar(&bankOpt.RistrictReference, "rr", false, "Raise error reference not found")
+}
+
+// runBank executes bank command and return exit code.
+func runBank(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, bankOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, bankOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	err = csvutil.Bank(r, w, bankOpt.BankOption)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+)
+
+func Test_runBank(t *testing.T) {
+	bankOpt.AccountNumber = "名前"
+	if c := runBank([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	bankOpt.AccountNumber = ""
+}
+
+func Test_runBankOnNoFile(t *testing.T) {
+	if c := runBank([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runBankOnFail(t *testing.T) {
+	if c := runBank([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runBankOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bankOpt.AccountNumber = "名前"
+	bankOpt.Overwrite = true
+	bankOpt.Backup = true
+	runBank([]string{tempFilePath()})
+	bankOpt.Backup = false
+	bankOpt.Overwrite = false
+	bankOpt.AccountNumber = ""
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runBankOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bankOpt.AccountNumber = "名前"
+	bankOpt.Overwrite = true
+	runBank([]string{tempFilePath()})
+	bankOpt.Overwrite = false
+	bankOpt.AccountNumber = ""
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := regexp.MustCompile(`^\d{7}$`)
+	if len(c[0]) != 2 {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if c[0][0] != "名前" || c[0][1] != "個数" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if !r.MatchString(c[1][0]) || c[1][1] != "1" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if !r.MatchString(c[2][0]) || c[2][1] != "2" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
+
+func Test_runBankOnInvalidAccountTypeFormat(t *testing.T) {
+	bankOpt.AccountType = "名前"
+	bankOpt.AccountTypeFormat = "foo"
+	if c := runBank([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	bankOpt.AccountTypeFormat = "code"
+	bankOpt.AccountType = ""
+}
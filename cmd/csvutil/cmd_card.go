@@ -0,0 +1,162 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdCard = &Command{
+	Run:       runCard,
+	UsageLine: "card [OPTIONS...] [FILE]",
+	Short:     "クレジットカード番号生成",
+	Long: `DESCRIPTION
+        指定した列にダミーのクレジットカード番号を出力します。
+        カード番号はブランドごとのプレフィックスと桁数に従い、Luhn アルゴリズムによるチェックディジットを持ちます。
+        決済サービスなどで公開されているテスト用カード番号とは重複しないように生成されます。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --column COLUMN_SYMBOL
+            対象となる列のシンボルを指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+
+        -ec, --expiry-column COLUMN_SYMBOL
+            有効期限（MM/YY）を出力する列のシンボルを指定します。
+            有効期限は翌月から5年以内のランダムな年月です。
+
+        -cc, --cvv-column COLUMN_SYMBOL
+            セキュリティコードを出力する列のシンボルを指定します。
+            American Express は4桁、それ以外は3桁です。
+
+        -bc, --brand-column COLUMN_SYMBOL
+            ブランド名を出力する列のシンボルを指定します。
+
+        -vr, --visa-rate PERCENTAGE
+            Visa の割合を指定します。0〜100までの整数を指定して下さい。
+
+        -mr, --mastercard-rate PERCENTAGE
+            Mastercard の割合を指定します。0〜100までの整数を指定して下さい。
+
+        -jr, --jcb-rate PERCENTAGE
+            JCB の割合を指定します。0〜100までの整数を指定して下さい。
+
+        -ar, --amex-rate PERCENTAGE
+            American Express の割合を指定します。0〜100までの整数を指定して下さい。
+
+        -dr, --diners-rate PERCENTAGE
+            Diners Club の割合を指定します。0〜100までの整数を指定して下さい。
+            ブランドの割合をいずれかでも指定する場合、合計が100になるように指定して下さい。
+            いずれも指定されていない場合、全てのブランドが均等に出力されます。
+
+        -hy, --hyphen
+            カード番号をハイフンで区切って出力します。
+            （例: 4111-1111-1111-1111, 3782-822463-10005）
+
+        -m, --mask
+            カード番号の先頭4桁と末尾4桁以外をマスクして出力します。
+            （例: --hyphen と同時に指定した場合 4111-****-****-1111）
+
+        -tn, --test-number
+            ランダムな番号ではなく、公開されているテスト用カード番号を出力します。
+	`,
+}
+
+type cmdCardOption struct {
+	csvutil.CardOption
+	Overwrite bool
+	Backup    bool
+}
+
+var cardOpt = cmdCardOption{}
+
+func init() {
+	cmdCard.Flag.BoolVar(&cardOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdCard.Flag.BoolVar(&cardOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdCard.Flag.BoolVar(&cardOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdCard.Flag.BoolVar(&cardOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdCard.Flag.BoolVar(&cardOpt.Backup, "backup", false, "Backup source file.")
+	cmdCard.Flag.BoolVar(&cardOpt.Backup, "b", false, "Backup source file.")
+	cmdCard.Flag.StringVar(&cardOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdCard.Flag.StringVar(&cardOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdCard.Flag.StringVar(&cardOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdCard.Flag.StringVar(&cardOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdCard.Flag.StringVar(&cardOpt.Column, "column", "", "Card number column symbol")
+	cmdCard.Flag.StringVar(&cardOpt.Column, "c", "", "Card number column symbol")
+	cmdCard.Flag.StringVar(&cardOpt.ExpiryColumn, "expiry-column", "", "Expiry column symbol")
+	cmdCard.Flag.StringVar(&cardOpt.ExpiryColumn, "ec", "", "Expiry column symbol")
+	cmdCard.Flag.StringVar(&cardOpt.CVVColumn, "cvv-column", "", "CVV column symbol")
+	cmdCard.Flag.StringVar(&cardOpt.CVVColumn, "cc", "", "CVV column symbol")
+	cmdCard.Flag.StringVar(&cardOpt.BrandColumn, "brand-column", "", "Brand column symbol")
+	cmdCard.Flag.StringVar(&cardOpt.BrandColumn, "bc", "", "Brand column symbol")
+	cmdCard.Flag.IntVar(&cardOpt.VisaRate, "visa-rate", 0, "Visa rate")
+	cmdCard.Flag.IntVar(&cardOpt.VisaRate, "vr", 0, "Visa rate")
+	cmdCard.Flag.IntVar(&cardOpt.MastercardRate, "mastercard-rate", 0, "Mastercard rate")
+	cmdCard.Flag.IntVar(&cardOpt.MastercardRate, "mr", 0, "Mastercard rate")
+	cmdCard.Flag.IntVar(&cardOpt.JCBRate, "jcb-rate", 0, "JCB rate")
+	cmdCard.Flag.IntVar(&cardOpt.JCBRate, "jr", 0, "JCB rate")
+	cmdCard.Flag.IntVar(&cardOpt.AmexRate, "amex-rate", 0, "American Express rate")
+	cmdCard.Flag.IntVar(&cardOpt.AmexRate, "ar", 0, "American Express rate")
+	cmdCard.Flag.IntVar(&cardOpt.DinersRate, "diners-rate", 0, "Diners Club rate")
+	cmdCard.Flag.IntVar(&cardOpt.DinersRate, "dr", 0, "Diners Club rate")
+	cmdCard.Flag.BoolVar(&cardOpt.Hyphen, "hyphen", false, "Separate number by hyphen")
+	cmdCard.Flag.BoolVar(&cardOpt.Hyphen, "hy", false, "Separate number by hyphen")
+	cmdCard.Flag.BoolVar(&cardOpt.Mask, "mask", false, "Mask number")
+	cmdCard.Flag.BoolVar(&cardOpt.Mask, "m", false, "Mask number")
+	cmdCard.Flag.BoolVar(&cardOpt.TestNumber, "test-number", false, "Output published test numbers")
+	cmdCard.Flag.BoolVar(&cardOpt.TestNumber, "tn", false, "Output published test numbers")
+}
+
+// runCard executes card command and return exit code.
+func runCard(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, cardOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, cardOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	err = csvutil.Card(r, w, cardOpt.CardOption)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+)
+
+func Test_runCard(t *testing.T) {
+	cardOpt.Column = "名前"
+	if c := runCard([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	cardOpt.Column = ""
+}
+
+func Test_runCardOnNoFile(t *testing.T) {
+	if c := runCard([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runCardOnFail(t *testing.T) {
+	if c := runCard([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runCardOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cardOpt.Column = "名前"
+	cardOpt.Overwrite = true
+	cardOpt.Backup = true
+	runCard([]string{tempFilePath()})
+	cardOpt.Backup = false
+	cardOpt.Overwrite = false
+	cardOpt.Column = ""
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runCardOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cardOpt.Column = "名前"
+	cardOpt.Hyphen = true
+	cardOpt.Overwrite = true
+	runCard([]string{tempFilePath()})
+	cardOpt.Overwrite = false
+	cardOpt.Hyphen = false
+	cardOpt.Column = ""
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := regexp.MustCompile(`^\d{4}-\d{4,6}-\d{4,5}(-\d{4})?$`)
+	if len(c[0]) != 2 {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if c[0][0] != "名前" || c[0][1] != "個数" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if !r.MatchString(c[1][0]) || c[1][1] != "1" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if !r.MatchString(c[2][0]) || c[2][1] != "2" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
+
+func Test_runCardOnInvalidRate(t *testing.T) {
+	cardOpt.Column = "名前"
+	cardOpt.VisaRate = 50
+	if c := runCard([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	cardOpt.VisaRate = 0
+	cardOpt.Column = ""
+}
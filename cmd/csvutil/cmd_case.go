@@ -0,0 +1,115 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdCase = &Command{
+	Run:       runCase,
+	UsageLine: "case [OPTIONS...] [FILE]",
+	Short:     "大文字・小文字変換",
+	Long: `DESCRIPTION
+        指定した列の値の英字の大文字・小文字を変換したCSVを出力します。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --column COLUMN_SYMBOL(S)
+            対象となる列のシンボルを指定します。指定されていない場合、全ての列が対象になります。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+            複数列を対象としたい場合は、foo:bar や 1:2のようにコロン区切りで指定して下さい。
+
+        -t, --to CASE
+            変換後の大文字・小文字を指定します。全角英字も対象になります。
+            対応している値:
+                upper: 全て大文字に変換します
+                lower: 全て小文字に変換します
+                title: 単語の先頭を大文字、それ以外を小文字に変換します
+	`,
+}
+
+type cmdCaseOption struct {
+	csvutil.CaseOption
+	// Overwrite to source. (default false)
+	Overwrite bool
+	// Backup source file. (default false)
+	Backup bool
+	// Column header or column index separated by colon.
+	Column string
+}
+
+var caseOpt = cmdCaseOption{}
+
+func init() {
+	cmdCase.Flag.BoolVar(&caseOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdCase.Flag.BoolVar(&caseOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdCase.Flag.BoolVar(&caseOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdCase.Flag.BoolVar(&caseOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdCase.Flag.BoolVar(&caseOpt.Backup, "backup", false, "Backup source file.")
+	cmdCase.Flag.BoolVar(&caseOpt.Backup, "b", false, "Backup source file.")
+	cmdCase.Flag.StringVar(&caseOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdCase.Flag.StringVar(&caseOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdCase.Flag.StringVar(&caseOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdCase.Flag.StringVar(&caseOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdCase.Flag.StringVar(&caseOpt.Column, "column", "", "Target column symbol")
+	cmdCase.Flag.StringVar(&caseOpt.Column, "c", "", "Target column symbol")
+	cmdCase.Flag.StringVar(&caseOpt.To, "to", "", "Letter case after changing")
+	cmdCase.Flag.StringVar(&caseOpt.To, "t", "", "Letter case after changing")
+}
+
+// runCase executes case command and return exit code.
+func runCase(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, caseOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, caseOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := caseOpt.CaseOption
+	opt.ColumnSyms = split(caseOpt.Column)
+	err = csvutil.Case(r, w, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
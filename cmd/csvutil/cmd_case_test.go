@@ -0,0 +1,44 @@
+package main
+
+import "testing"
+
+func Example_runCase() {
+	caseOpt.Column = "英名"
+	caseOpt.To = "title"
+	runCase([]string{testFilePath("spaces.csv")})
+	caseOpt.To = ""
+	caseOpt.Column = ""
+	// Output: 名前,英名
+	// "　山田　　太郎 "," Taro  Yamada"
+	// 佐藤 花子,Hanako Sato
+}
+
+func Test_runCase(t *testing.T) {
+	caseOpt.To = "upper"
+	if c := runCase([]string{testFilePath("spaces.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	caseOpt.To = ""
+}
+
+func Test_runCaseWithoutTo(t *testing.T) {
+	if c := runCase([]string{testFilePath("spaces.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runCaseOnNoFile(t *testing.T) {
+	caseOpt.To = "upper"
+	if c := runCase([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	caseOpt.To = ""
+}
+
+func Test_runCaseOnFail(t *testing.T) {
+	caseOpt.To = "upper"
+	if c := runCase([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	caseOpt.To = ""
+}
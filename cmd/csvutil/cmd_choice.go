@@ -0,0 +1,217 @@
+package main
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pinzolo/csvutil"
+	"github.com/pkg/errors"
+)
+
+var cmdChoice = &Command{
+	Run:       runChoice,
+	UsageLine: "choice [OPTIONS...] [FILE]",
+	Short:     "選択値生成",
+	Long: `DESCRIPTION
+        指定した列に、候補の値から重み付きでランダムに選択した値を出力します。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --column COLUMN_SYMBOL
+            対象となる列のシンボルを指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+
+        -v, --values VALUES
+            候補の値を active:70,suspended:20,deleted:10 のようにカンマ区切りで指定します。
+            コロンの後ろの数値は選択される確率（%）です。確率の合計は100以下でなければいけません。
+            確率が省略された値には、残りの確率が均等に割り当てられます。
+            全ての値に確率が指定され、合計が100未満の場合は、残りの確率で空文字が出力されます。
+
+        -vf, --values-file FILE
+            候補の値を記述したCSVファイル（UTF-8, ヘッダーなし）を指定します。
+            1列目が値、2列目が確率（%）です。確率は省略できます。
+
+        -s, --source FILE
+            候補の値を取得するCSVファイルを指定します。1行目はヘッダー行として扱われます。
+            --source-column オプションで指定した列の重複を除いた空ではない値が、均等な確率で候補になります。
+
+        -sc, --source-column COLUMN_SYMBOL
+            --source オプションで指定したCSVファイルの、候補の値を取得する列のシンボルを指定します。
+
+        -se, --source-encoding ENCODING
+            --source オプションで指定したCSVファイルの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとみなします。
+
+        -nr, --no-repeat
+            同じ値を2回以上選択しません（非復元抽出）。候補の値が尽きた場合はエラーになります。
+	`,
+}
+
+type cmdChoiceOption struct {
+	csvutil.ChoiceOption
+	Overwrite bool
+	Backup    bool
+	// ValuesText is values with weight separated by comma. (e.g. active:70,deleted:30)
+	ValuesText string
+	// ValuesFile is path of CSV file that has values and weights.
+	ValuesFile string
+	// Source is path of CSV file that has values in column.
+	Source string
+}
+
+var choiceOpt = cmdChoiceOption{}
+
+func init() {
+	cmdChoice.Flag.BoolVar(&choiceOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdChoice.Flag.BoolVar(&choiceOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdChoice.Flag.BoolVar(&choiceOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdChoice.Flag.BoolVar(&choiceOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdChoice.Flag.BoolVar(&choiceOpt.Backup, "backup", false, "Backup source file.")
+	cmdChoice.Flag.BoolVar(&choiceOpt.Backup, "b", false, "Backup source file.")
+	cmdChoice.Flag.StringVar(&choiceOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdChoice.Flag.StringVar(&choiceOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdChoice.Flag.StringVar(&choiceOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdChoice.Flag.StringVar(&choiceOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdChoice.Flag.StringVar(&choiceOpt.Column, "column", "", "Target column symbol")
+	cmdChoice.Flag.StringVar(&choiceOpt.Column, "c", "", "Target column symbol")
+	cmdChoice.Flag.StringVar(&choiceOpt.ValuesText, "values", "", "Values with weight")
+	cmdChoice.Flag.StringVar(&choiceOpt.ValuesText, "v", "", "Values with weight")
+	cmdChoice.Flag.StringVar(&choiceOpt.ValuesFile, "values-file", "", "Values file path")
+	cmdChoice.Flag.StringVar(&choiceOpt.ValuesFile, "vf", "", "Values file path")
+	cmdChoice.Flag.StringVar(&choiceOpt.Source, "source", "", "Source file path")
+	cmdChoice.Flag.StringVar(&choiceOpt.Source, "s", "", "Source file path")
+	cmdChoice.Flag.StringVar(&choiceOpt.SourceColumn, "source-column", "", "Column symbol of source file")
+	cmdChoice.Flag.StringVar(&choiceOpt.SourceColumn, "sc", "", "Column symbol of source file")
+	cmdChoice.Flag.StringVar(&choiceOpt.SourceEncoding, "source-encoding", "", "Encoding of source file")
+	cmdChoice.Flag.StringVar(&choiceOpt.SourceEncoding, "se", "", "Encoding of source file")
+	cmdChoice.Flag.BoolVar(&choiceOpt.NoRepeat, "no-repeat", false, "Choose each value only once")
+	cmdChoice.Flag.BoolVar(&choiceOpt.NoRepeat, "nr", false, "Choose each value only once")
+}
+
+// runChoice executes choice command and return exit code.
+func runChoice(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, choiceOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, choiceOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := choiceOpt.ChoiceOption
+	if choiceOpt.ValuesText != "" {
+		opt.Values, opt.Weights = parseChoices(strings.Split(choiceOpt.ValuesText, ","))
+	} else if choiceOpt.ValuesFile != "" {
+		opt.Values, opt.Weights, err = readChoices(choiceOpt.ValuesFile)
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	if choiceOpt.SourceColumn != "" && choiceOpt.Source == "" {
+		return handleError(errors.New("no source file"))
+	}
+	var src io.Reader
+	if choiceOpt.Source != "" {
+		var srcf func()
+		src, srcf, err = reader(choiceOpt.Source)
+		if srcf != nil {
+			defer srcf()
+		}
+		if err != nil {
+			return handleError(err)
+		}
+	}
+	err = csvutil.Choice(r, w, src, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
+
+// parseChoices parses values like value:weight.
+// When text after last colon is not number, whole text is treated as value.
+func parseChoices(ss []string) ([]string, []int) {
+	vals := make([]string, len(ss))
+	weights := make([]int, len(ss))
+	for i, s := range ss {
+		vals[i] = s
+		weights[i] = -1
+		if n := strings.LastIndex(s, ":"); n != -1 {
+			if w, err := strconv.Atoi(s[n+1:]); err == nil {
+				vals[i] = s[:n]
+				weights[i] = w
+			}
+		}
+	}
+	return vals, weights
+}
+
+func readChoices(path string) ([]string, []int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed open")
+	}
+	defer f.Close()
+
+	cr := csv.NewReader(f)
+	cr.FieldsPerRecord = -1
+	recs, err := cr.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	vals := make([]string, len(recs))
+	weights := make([]int, len(recs))
+	for i, rec := range recs {
+		vals[i] = rec[0]
+		weights[i] = -1
+		if len(rec) > 1 && rec[1] != "" {
+			if weights[i], err = strconv.Atoi(rec[1]); err != nil {
+				return nil, nil, errors.Errorf("invalid weight: %s", rec[1])
+			}
+		}
+	}
+	return vals, weights, nil
+}
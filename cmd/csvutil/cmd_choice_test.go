@@ -0,0 +1,93 @@
+package main
+
+import "testing"
+
+func Example_runChoice() {
+	choiceOpt.Column = "個数"
+	choiceOpt.ValuesText = "たくさん:100,少し"
+	runChoice([]string{testFilePath("utf8.csv")})
+	choiceOpt.ValuesText = ""
+	choiceOpt.Column = ""
+	// Output: 名前,個数
+	// りんご,たくさん
+	// みかん,たくさん
+}
+
+func Test_runChoice(t *testing.T) {
+	choiceOpt.Column = "個数"
+	choiceOpt.ValuesFile = testFilePath("choices.csv")
+	if c := runChoice([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	choiceOpt.ValuesFile = ""
+	choiceOpt.Column = ""
+}
+
+func Test_runChoiceWithSource(t *testing.T) {
+	choiceOpt.Column = "個数"
+	choiceOpt.Source = testFilePath("utf8.csv")
+	choiceOpt.SourceColumn = "名前"
+	choiceOpt.NoRepeat = true
+	if c := runChoice([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	choiceOpt.NoRepeat = false
+	choiceOpt.SourceColumn = ""
+	choiceOpt.Source = ""
+	choiceOpt.Column = ""
+}
+
+func Test_runChoiceOnNoValuesFile(t *testing.T) {
+	choiceOpt.Column = "個数"
+	choiceOpt.ValuesFile = testFilePath("no-file.csv")
+	if c := runChoice([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	choiceOpt.ValuesFile = ""
+	choiceOpt.Column = ""
+}
+
+func Test_runChoiceOnNoSourceFile(t *testing.T) {
+	choiceOpt.Column = "個数"
+	choiceOpt.Source = testFilePath("no-file.csv")
+	choiceOpt.SourceColumn = "名前"
+	if c := runChoice([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	choiceOpt.SourceColumn = ""
+	choiceOpt.Source = ""
+	choiceOpt.Column = ""
+}
+
+func Test_runChoiceOnSourceColumnWithoutSource(t *testing.T) {
+	choiceOpt.Column = "個数"
+	choiceOpt.SourceColumn = "名前"
+	if c := runChoice([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	choiceOpt.SourceColumn = ""
+	choiceOpt.Column = ""
+}
+
+func Test_runChoiceOnNoFile(t *testing.T) {
+	choiceOpt.Column = "個数"
+	choiceOpt.ValuesText = "a"
+	if c := runChoice([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	choiceOpt.ValuesText = ""
+	choiceOpt.Column = ""
+}
+
+func Test_parseChoices(t *testing.T) {
+	vals, weights := parseChoices([]string{"active:70", "12:30:x", "deleted"})
+	if vals[0] != "active" || weights[0] != 70 {
+		t.Errorf("Unexpected choice: %s:%d", vals[0], weights[0])
+	}
+	if vals[1] != "12:30:x" || weights[1] != -1 {
+		t.Errorf("Unexpected choice: %s:%d", vals[1], weights[1])
+	}
+	if vals[2] != "deleted" || weights[2] != -1 {
+		t.Errorf("Unexpected choice: %s:%d", vals[2], weights[2])
+	}
+}
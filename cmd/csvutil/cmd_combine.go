@@ -9,7 +9,8 @@ var cmdCombine = &Command{
 	UsageLine: "combine [OPTIONS...] [FILE]",
 	Short:     "列結合",
 	Long: `DESCRIPTION
-        指定された列を結合した値を、指定した列に出力します。
+        指定された列を結合した値を、指定した列もしくは新しい列に出力します。
+        --template オプションを指定すると、ヘッダーテキストを参照するテンプレートで値を組み立てます。
 
 ARGUMENTS
         FILE
@@ -55,9 +56,27 @@ OPTIONS
             結合後の値を入力する列のシンボルを指定します。
             列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
             --no-header オプションが指定された場合、インデックスしか受け入れません。
+            --new-column オプションと同時に指定することはできません。
+
+        -n, --new-column HEADER
+            結合後の値を入力する列を新たに作成し、そのヘッダーテキストを指定します。
+            --no-header オプションが指定された場合、ヘッダーは出力されません。
+
+        --before COLUMN_SYMBOL
+            新しい列をこの列の前に挿入します。指定されていない場合、最後の列として追加します。
+            --new-column オプションと同時に使用して下さい。
 
         -dl, --delimiter TEXT
             結合時にデリミタとする文字列を指定します。初期値は空文字です。
+
+        -se, --skip-empty
+            空文字の値を結合対象から除外します。デリミタが連続することを防ぎます。
+
+        -t, --template TEMPLATE
+            結合後の値を組み立てるテンプレートを指定します。
+            {{.zip}} {{.pref}}{{.city}} のように、ヘッダーテキストを参照して記述します。
+            --no-header オプションが指定された場合、{{.column1}} のように column + 列番号（1開始）で参照します。
+            このオプションが指定された場合、--source, --delimiter, --skip-empty オプションは無視されます。
 	`,
 }
 
@@ -90,6 +109,13 @@ func init() {
 	cmdCombine.Flag.StringVar(&combineOpt.Destination, "d", "", "Destination column symbol")
 	cmdCombine.Flag.StringVar(&combineOpt.Delimiter, "delimiter", "", "Delimiter")
 	cmdCombine.Flag.StringVar(&combineOpt.Delimiter, "dl", "", "Delimiter")
+	cmdCombine.Flag.StringVar(&combineOpt.NewColumn, "new-column", "", "Header of new column")
+	cmdCombine.Flag.StringVar(&combineOpt.NewColumn, "n", "", "Header of new column")
+	cmdCombine.Flag.StringVar(&combineOpt.Before, "before", "", "Column symbol that new column is inserted before")
+	cmdCombine.Flag.BoolVar(&combineOpt.SkipEmpty, "skip-empty", false, "Skip empty values")
+	cmdCombine.Flag.BoolVar(&combineOpt.SkipEmpty, "se", false, "Skip empty values")
+	cmdCombine.Flag.StringVar(&combineOpt.Template, "template", "", "Template for combined value")
+	cmdCombine.Flag.StringVar(&combineOpt.Template, "t", "", "Template for combined value")
 }
 
 // runCombine executes combine command and return exit code.
@@ -13,6 +13,32 @@ func Example_runCombine() {
 	// みかん,みかん2
 }
 
+func Example_runCombineWithNewColumn() {
+	combineOpt.Source = "0:1"
+	combineOpt.NewColumn = "ラベル"
+	combineOpt.Before = "1"
+	combineOpt.Delimiter = "-"
+	runCombine([]string{testFilePath("utf8.csv")})
+	combineOpt.Source = ""
+	combineOpt.NewColumn = ""
+	combineOpt.Before = ""
+	combineOpt.Delimiter = ""
+	// Output: 名前,ラベル,個数
+	// りんご,りんご-1,1
+	// みかん,みかん-2,2
+}
+
+func Example_runCombineWithTemplate() {
+	combineOpt.Template = "{{.名前}}×{{.個数}}"
+	combineOpt.NewColumn = "ラベル"
+	runCombine([]string{testFilePath("utf8.csv")})
+	combineOpt.Template = ""
+	combineOpt.NewColumn = ""
+	// Output: 名前,個数,ラベル
+	// りんご,1,りんご×1
+	// みかん,2,みかん×2
+}
+
 func Test_runCombine(t *testing.T) {
 	combineOpt.Source = "0:1"
 	combineOpt.Destination = "1"
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdCompany = &Command{
+	Run:       runCompany,
+	UsageLine: "company [OPTIONS...] [FILE]",
+	Short:     "会社名生成",
+	Long: `DESCRIPTION
+        指定した列にダミーの会社名を出力します。
+        部署や役職も合わせて出力できます。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --column COLUMN_SYMBOL
+            会社名を出力する列のシンボルを指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+
+        -k, --kana COLUMN_SYMBOL
+            会社名（カナ）を出力する列のシンボルを指定します。
+            カナには株式会社などの会社の種類は含まれません。
+
+        -d, --department COLUMN_SYMBOL
+            部署を出力する列のシンボルを指定します。
+
+        -t, --title COLUMN_SYMBOL
+            役職を出力する列のシンボルを指定します。
+
+        -p, --position POSITION
+            株式会社・合同会社・有限会社の位置を指定します。初期値は after です。
+                before: 前株（例: 株式会社山田商事）
+                after : 後株（例: 山田商事株式会社）
+                random: 前株と後株をランダムに出力します
+
+        -en, --english
+            会社名・部署・役職を英語で出力します。（例: Yamada Trading Co., Ltd.）
+            会社名（カナ）はカナのまま出力されます。
+	`,
+}
+
+type cmdCompanyOption struct {
+	csvutil.CompanyOption
+	Overwrite bool
+	Backup    bool
+}
+
+var companyOpt = cmdCompanyOption{}
+
+func init() {
+	cmdCompany.Flag.BoolVar(&companyOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdCompany.Flag.BoolVar(&companyOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdCompany.Flag.BoolVar(&companyOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdCompany.Flag.BoolVar(&companyOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdCompany.Flag.BoolVar(&companyOpt.Backup, "backup", false, "Backup source file.")
+	cmdCompany.Flag.BoolVar(&companyOpt.Backup, "b", false, "Backup source file.")
+	cmdCompany.Flag.StringVar(&companyOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdCompany.Flag.StringVar(&companyOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdCompany.Flag.StringVar(&companyOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdCompany.Flag.StringVar(&companyOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdCompany.Flag.StringVar(&companyOpt.Column, "column", "", "Company name column symbol")
+	cmdCompany.Flag.StringVar(&companyOpt.Column, "c", "", "Company name column symbol")
+	cmdCompany.Flag.StringVar(&companyOpt.Kana, "kana", "", "Kana of company name column symbol")
+	cmdCompany.Flag.StringVar(&companyOpt.Kana, "k", "", "Kana of company name column symbol")
+	cmdCompany.Flag.StringVar(&companyOpt.Department, "department", "", "Department column symbol")
+	cmdCompany.Flag.StringVar(&companyOpt.Department, "d", "", "Department column symbol")
+	cmdCompany.Flag.StringVar(&companyOpt.Title, "title", "", "Job title column symbol")
+	cmdCompany.Flag.StringVar(&companyOpt.Title, "t", "", "Job title column symbol")
+	cmdCompany.Flag.StringVar(&companyOpt.Position, "position", "after", "Position of company type")
+	cmdCompany.Flag.StringVar(&companyOpt.Position, "p", "after", "Position of company type")
+	cmdCompany.Flag.BoolVar(&companyOpt.English, "english", false, "Output in English")
+	cmdCompany.Flag.BoolVar(&companyOpt.English, "en", false, "Output in English")
+}
+
+// runCompany executes company command and return exit code.
+func runCompany(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, companyOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, companyOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	err = csvutil.Company(r, w, companyOpt.CompanyOption)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+)
+
+func Test_runCompany(t *testing.T) {
+	companyOpt.Column = "名前"
+	if c := runCompany([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	companyOpt.Column = ""
+}
+
+func Test_runCompanyOnNoFile(t *testing.T) {
+	if c := runCompany([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runCompanyOnFail(t *testing.T) {
+	if c := runCompany([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runCompanyOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	companyOpt.Column = "名前"
+	companyOpt.Overwrite = true
+	companyOpt.Backup = true
+	runCompany([]string{tempFilePath()})
+	companyOpt.Backup = false
+	companyOpt.Overwrite = false
+	companyOpt.Column = ""
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runCompanyOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	companyOpt.Column = "名前"
+	companyOpt.Overwrite = true
+	runCompany([]string{tempFilePath()})
+	companyOpt.Overwrite = false
+	companyOpt.Column = ""
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := regexp.MustCompile(`(株式|合同|有限)会社$`)
+	if len(c[0]) != 2 {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if c[0][0] != "名前" || c[0][1] != "個数" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if !r.MatchString(c[1][0]) || c[1][1] != "1" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if !r.MatchString(c[2][0]) || c[2][1] != "2" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
+
+func Test_runCompanyOnInvalidPosition(t *testing.T) {
+	companyOpt.Column = "名前"
+	companyOpt.Position = "foo"
+	if c := runCompany([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	companyOpt.Position = "after"
+	companyOpt.Column = ""
+}
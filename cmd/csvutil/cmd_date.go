@@ -0,0 +1,141 @@
+package main
+
+import (
+	"time"
+
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdDate = &Command{
+	Run:       runDate,
+	UsageLine: "date [OPTIONS...] [FILE]",
+	Short:     "日時生成",
+	Long: `DESCRIPTION
+        指定した列に from <= d <= to となるランダムな日時を出力します。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --column COLUMN_SYMBOL
+            対象となる列のシンボルを指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+
+        -f, --from DATE
+            出力する日時の最小値を指定します。（初期値: 1970-01-01）
+            2006-01-02, 2006-01-02 15:04:05, 2006/01/02, 15:04:05, RFC3339, 令和5年4月1日 などの形式と --format オプションで指定した形式を受け入れます。
+
+        -t, --to DATE
+            出力する日時の最大値を指定します。（初期値: 実行日）
+            受け入れる形式は --from オプションと同じです。
+
+        -fmt, --format FORMAT
+            出力する日時のフォーマットを Go の time パッケージのレイアウトで指定します。（初期値: 2006-01-02）
+            例:
+                2006/01/02          : 日付
+                2006-01-02 15:04:05 : 日時
+                15:04               : 時刻のみ
+                2006-01-02T15:04:05Z07:00 : RFC3339
+
+        --era
+            日付を和暦（令和5年4月1日）で出力します。指定された場合、--format オプションは無視されます。
+
+        -tz, --timezone TIMEZONE
+            --from, --to の解釈と出力に使用するタイムゾーンを Asia/Tokyo や UTC のように指定します。
+            指定されていない場合、実行環境のタイムゾーンを使用します。
+
+        -a, --after COLUMN_SYMBOL
+            出力する日時が、同じ行の指定した列の日時より後になるようにします。（created_at < updated_at など）
+            指定した列の値は --format オプションで指定した形式（--era オプション指定時は和暦）で解釈されます。
+            指定した列の値が空の場合は、この制約は無視されます。
+	`,
+}
+
+type cmdDateOption struct {
+	csvutil.DateOption
+	Overwrite bool
+	Backup    bool
+}
+
+var dateOpt = cmdDateOption{}
+
+func init() {
+	today := time.Now().Format("2006-01-02")
+	cmdDate.Flag.BoolVar(&dateOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdDate.Flag.BoolVar(&dateOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdDate.Flag.BoolVar(&dateOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdDate.Flag.BoolVar(&dateOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdDate.Flag.BoolVar(&dateOpt.Backup, "backup", false, "Backup source file.")
+	cmdDate.Flag.BoolVar(&dateOpt.Backup, "b", false, "Backup source file.")
+	cmdDate.Flag.StringVar(&dateOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdDate.Flag.StringVar(&dateOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdDate.Flag.StringVar(&dateOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdDate.Flag.StringVar(&dateOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdDate.Flag.StringVar(&dateOpt.Column, "column", "", "Target column symbol")
+	cmdDate.Flag.StringVar(&dateOpt.Column, "c", "", "Target column symbol")
+	cmdDate.Flag.StringVar(&dateOpt.From, "from", "1970-01-01", "Minimum date")
+	cmdDate.Flag.StringVar(&dateOpt.From, "f", "1970-01-01", "Minimum date")
+	cmdDate.Flag.StringVar(&dateOpt.To, "to", today, "Maximum date")
+	cmdDate.Flag.StringVar(&dateOpt.To, "t", today, "Maximum date")
+	cmdDate.Flag.StringVar(&dateOpt.Format, "format", "2006-01-02", "Output format")
+	cmdDate.Flag.StringVar(&dateOpt.Format, "fmt", "2006-01-02", "Output format")
+	cmdDate.Flag.BoolVar(&dateOpt.Era, "era", false, "Output in Japanese era")
+	cmdDate.Flag.StringVar(&dateOpt.Timezone, "timezone", "", "Timezone")
+	cmdDate.Flag.StringVar(&dateOpt.Timezone, "tz", "", "Timezone")
+	cmdDate.Flag.StringVar(&dateOpt.After, "after", "", "Column symbol that generated date is after")
+	cmdDate.Flag.StringVar(&dateOpt.After, "a", "", "Column symbol that generated date is after")
+}
+
+// runDate executes date command and return exit code.
+func runDate(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, dateOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, dateOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	err = csvutil.Date(r, w, dateOpt.DateOption)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
@@ -0,0 +1,70 @@
+package main
+
+import "testing"
+
+func Example_runDate() {
+	dateOpt.Column = "更新日"
+	dateOpt.From = "2019-05-01"
+	dateOpt.To = "2019-05-01"
+	dateOpt.Era = true
+	runDate([]string{testFilePath("dates.csv")})
+	dateOpt.Era = false
+	dateOpt.To = ""
+	dateOpt.From = ""
+	dateOpt.Column = ""
+	// Output: 名前,登録日,更新日
+	// 山田 太郎,2018-04-01,令和元年5月1日
+	// 佐藤 花子,2018-12-30,令和元年5月1日
+}
+
+func Test_runDate(t *testing.T) {
+	dateOpt.Column = "更新日"
+	dateOpt.From = "2018-01-01"
+	dateOpt.To = "2018-12-31"
+	dateOpt.After = "登録日"
+	if c := runDate([]string{testFilePath("dates.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	dateOpt.After = ""
+	dateOpt.To = ""
+	dateOpt.From = ""
+	dateOpt.Column = ""
+}
+
+func Test_runDateOnAfterOutOfRange(t *testing.T) {
+	dateOpt.Column = "更新日"
+	dateOpt.From = "2018-01-01"
+	dateOpt.To = "2018-12-30"
+	dateOpt.After = "登録日"
+	if c := runDate([]string{testFilePath("dates.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	dateOpt.After = ""
+	dateOpt.To = ""
+	dateOpt.From = ""
+	dateOpt.Column = ""
+}
+
+func Test_runDateOnNoFile(t *testing.T) {
+	dateOpt.Column = "更新日"
+	dateOpt.From = "2018-01-01"
+	dateOpt.To = "2018-12-31"
+	if c := runDate([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	dateOpt.To = ""
+	dateOpt.From = ""
+	dateOpt.Column = ""
+}
+
+func Test_runDateOnFail(t *testing.T) {
+	dateOpt.Column = "更新日"
+	dateOpt.From = "2018-01-01"
+	dateOpt.To = "2018-12-31"
+	if c := runDate([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	dateOpt.To = ""
+	dateOpt.From = ""
+	dateOpt.Column = ""
+}
@@ -59,6 +59,26 @@ OPTIONS
                 i.softbank.ne.jp
                 ymobile.ne.jp
                 emobile.ne.jp
+
+        -r, --reference COLUMN_SYMBOL
+            メールアドレスのローカル部を生成するために参照するローマ字の名前の列のシンボルを指定します。
+            名と姓は空白で区切られている必要があり、taro.yamada, t-yamada, yamada123 のようなローカル部を生成します。
+            参照した値にアルファベットが含まれない場合、ランダムなローカル部を使用します。
+
+        -lf, --last-name-first
+            --reference オプションで参照する名前が姓→名の順で記載されている場合に指定します。（例: YAMADA Taro）
+
+        -sd, --safe-domain
+            例示用に予約されたドメインのみを使用し、実在の人物にメールが届かないようにします。
+            --mobile-rate オプションと同時には使用できません。
+            使用するドメインは下記の通りです。
+                example.com
+                example.net
+                example.org
+                example.jp
+                example.co.jp
+                example.ne.jp
+                example.invalid
 	`,
 }
 
@@ -85,6 +105,12 @@ func init() {
 	cmdEmail.Flag.StringVar(&emailOpt.Column, "c", "", "Target column symbol")
 	cmdEmail.Flag.IntVar(&emailOpt.MobileRate, "mobile-rate", 0, "Mobile email address rate")
 	cmdEmail.Flag.IntVar(&emailOpt.MobileRate, "mr", 0, "Mobile email address rate")
+	cmdEmail.Flag.StringVar(&emailOpt.Reference, "reference", "", "Reference romanized name column symbol")
+	cmdEmail.Flag.StringVar(&emailOpt.Reference, "r", "", "Reference romanized name column symbol")
+	cmdEmail.Flag.BoolVar(&emailOpt.LastNameFirst, "last-name-first", false, "Reference name is written in order of last name and first name")
+	cmdEmail.Flag.BoolVar(&emailOpt.LastNameFirst, "lf", false, "Reference name is written in order of last name and first name")
+	cmdEmail.Flag.BoolVar(&emailOpt.SafeDomain, "safe-domain", false, "Use only reserved domains")
+	cmdEmail.Flag.BoolVar(&emailOpt.SafeDomain, "sd", false, "Use only reserved domains")
 }
 
 // runEmail executes email command and return exit code.
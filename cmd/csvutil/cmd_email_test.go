@@ -13,6 +13,21 @@ func Test_runEmail(t *testing.T) {
 	emailOpt.Column = ""
 }
 
+func Test_runEmailOnSafeDomain(t *testing.T) {
+	emailOpt.Column = "名前"
+	emailOpt.SafeDomain = true
+	emailOpt.MobileRate = 10
+	if c := runEmail([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	emailOpt.MobileRate = 0
+	if c := runEmail([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	emailOpt.SafeDomain = false
+	emailOpt.Column = ""
+}
+
 func Test_runEmailOnNoFile(t *testing.T) {
 	if c := runEmail([]string{testFilePath("no-file.csv")}); c == 0 {
 		t.Fatalf("Invalid failed exit code: %d", c)
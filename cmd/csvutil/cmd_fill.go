@@ -0,0 +1,135 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdFill = &Command{
+	Run:       runFill,
+	UsageLine: "fill [OPTIONS...] [FILE]",
+	Short:     "空セル補完",
+	Long: `DESCRIPTION
+        指定した列の空のセルを、指定した値や前後の行の値、他の列の値で埋めたCSVを出力します。
+        Excel から出力したグループ化された帳票のように、同じ値が省略されたデータの補完に使用できます。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --column COLUMN_SYMBOL(S)
+            対象となる列のシンボルを指定します。指定されていない場合、全ての列が対象になります。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+            複数列を対象としたい場合は、foo:bar や 1:2のようにコロン区切りで指定して下さい。
+
+        -v, --value TEXT
+            空のセルに入力する値を指定します。
+            --down, --up, --from オプションと同時に指定された場合、補完する値が見つからなかったときに使用されます。
+
+        -d, --down
+            直前の行の空ではない値で埋めます。
+
+        -u, --up
+            直後の行の空ではない値で埋めます。
+            値が見つかるまでの行はメモリ上に保持されます。
+
+        -f, --from COLUMN_SYMBOL
+            同じ行の指定した列の値で埋めます。
+            --down, --up, --from オプションはどれか1つしか指定できません。
+
+        -s, --space
+            半角スペース・全角スペースのみのセルも空のセルとして扱います。
+	`,
+}
+
+type cmdFillOption struct {
+	csvutil.FillOption
+	// Overwrite to source. (default false)
+	Overwrite bool
+	// Backup source file. (default false)
+	Backup bool
+	// Column header or column index separated by colon.
+	Column string
+}
+
+var fillOpt = cmdFillOption{}
+
+func init() {
+	cmdFill.Flag.BoolVar(&fillOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdFill.Flag.BoolVar(&fillOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdFill.Flag.BoolVar(&fillOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdFill.Flag.BoolVar(&fillOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdFill.Flag.BoolVar(&fillOpt.Backup, "backup", false, "Backup source file.")
+	cmdFill.Flag.BoolVar(&fillOpt.Backup, "b", false, "Backup source file.")
+	cmdFill.Flag.StringVar(&fillOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdFill.Flag.StringVar(&fillOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdFill.Flag.StringVar(&fillOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdFill.Flag.StringVar(&fillOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdFill.Flag.StringVar(&fillOpt.Column, "column", "", "Target column symbol")
+	cmdFill.Flag.StringVar(&fillOpt.Column, "c", "", "Target column symbol")
+	cmdFill.Flag.StringVar(&fillOpt.Value, "value", "", "Value for filling")
+	cmdFill.Flag.StringVar(&fillOpt.Value, "v", "", "Value for filling")
+	cmdFill.Flag.BoolVar(&fillOpt.Down, "down", false, "Fill with previous value")
+	cmdFill.Flag.BoolVar(&fillOpt.Down, "d", false, "Fill with previous value")
+	cmdFill.Flag.BoolVar(&fillOpt.Up, "up", false, "Fill with next value")
+	cmdFill.Flag.BoolVar(&fillOpt.Up, "u", false, "Fill with next value")
+	cmdFill.Flag.StringVar(&fillOpt.From, "from", "", "Column symbol for filling value")
+	cmdFill.Flag.StringVar(&fillOpt.From, "f", "", "Column symbol for filling value")
+	cmdFill.Flag.BoolVar(&fillOpt.Space, "space", false, "Treat space only cell as empty")
+	cmdFill.Flag.BoolVar(&fillOpt.Space, "s", false, "Treat space only cell as empty")
+}
+
+// runFill executes fill command and return exit code.
+func runFill(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, fillOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, fillOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := fillOpt.FillOption
+	opt.ColumnSyms = split(fillOpt.Column)
+	err = csvutil.Fill(r, w, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
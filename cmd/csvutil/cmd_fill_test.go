@@ -0,0 +1,59 @@
+package main
+
+import "testing"
+
+func Example_runFill() {
+	fillOpt.Column = "部署"
+	fillOpt.Down = true
+	runFill([]string{testFilePath("grouped.csv")})
+	fillOpt.Down = false
+	fillOpt.Column = ""
+	// Output: 部署,名前,ニックネーム
+	// 営業部,山田 太郎,
+	// 営業部,佐藤 花子,はなこ
+	// 開発部,鈴木 一郎," "
+}
+
+func Example_runFillWithFrom() {
+	fillOpt.Column = "ニックネーム"
+	fillOpt.From = "名前"
+	fillOpt.Space = true
+	runFill([]string{testFilePath("grouped.csv")})
+	fillOpt.Space = false
+	fillOpt.From = ""
+	fillOpt.Column = ""
+	// Output: 部署,名前,ニックネーム
+	// 営業部,山田 太郎,山田 太郎
+	// ,佐藤 花子,はなこ
+	// 開発部,鈴木 一郎,鈴木 一郎
+}
+
+func Test_runFill(t *testing.T) {
+	fillOpt.Value = "N/A"
+	if c := runFill([]string{testFilePath("grouped.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	fillOpt.Value = ""
+}
+
+func Test_runFillWithoutValue(t *testing.T) {
+	if c := runFill([]string{testFilePath("grouped.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runFillOnNoFile(t *testing.T) {
+	fillOpt.Up = true
+	if c := runFill([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	fillOpt.Up = false
+}
+
+func Test_runFillOnFail(t *testing.T) {
+	fillOpt.Up = true
+	if c := runFill([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	fillOpt.Up = false
+}
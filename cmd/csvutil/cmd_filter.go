@@ -55,9 +55,27 @@ OPTIONS
         -p, --pattern PATTERN
             置換対象のパターンです。ここに指定したパターンにマッチする文字列を持つ行を抽出します。
 
+        -pf, --patterns-file FILE
+            抽出対象の値を1行に1つずつ記載したファイルのパスを指定します。
+            ファイルに記載された値は完全一致で比較されます。
+            --pattern オプションと同時に指定した場合は、どちらかにマッチする値を対象とします。
+
         -re, --regex, --regexp
             このオプションが指定されると --pattern に指定された値は正規表現と見なされます。
             初期値は false で、単純な曖昧検索を行います。
+
+        -x, --exact
+            このオプションが指定されると --pattern に指定された値と値全体が一致する場合のみマッチと見なします。
+
+        -i, --ignore-case
+            このオプションが指定されると大文字と小文字を区別せずに比較します。
+
+        -a, --all
+            このオプションが指定されると対象となるすべての列がマッチした行のみを抽出します。
+            初期値は false で、いずれかの列がマッチした行を抽出します。
+
+        -v, --invert
+            このオプションが指定されるとマッチしなかった行のみを抽出します。
 	`,
 }
 
@@ -66,6 +84,8 @@ type cmdFilterOption struct {
 	Overwrite bool
 	Backup    bool
 	Column    string
+	// Path of file that has target values per line.
+	PatternsFile string
 }
 
 var filterOpt = cmdFilterOption{}
@@ -88,6 +108,16 @@ func init() {
 	cmdFilter.Flag.BoolVar(&filterOpt.Regexp, "regexp", false, "Pattern is regex")
 	cmdFilter.Flag.BoolVar(&filterOpt.Regexp, "regex", false, "Pattern is regex")
 	cmdFilter.Flag.BoolVar(&filterOpt.Regexp, "re", false, "Pattern is regex")
+	cmdFilter.Flag.StringVar(&filterOpt.PatternsFile, "patterns-file", "", "Patterns file path")
+	cmdFilter.Flag.StringVar(&filterOpt.PatternsFile, "pf", "", "Patterns file path")
+	cmdFilter.Flag.BoolVar(&filterOpt.Exact, "exact", false, "Match whole value")
+	cmdFilter.Flag.BoolVar(&filterOpt.Exact, "x", false, "Match whole value")
+	cmdFilter.Flag.BoolVar(&filterOpt.IgnoreCase, "ignore-case", false, "Ignore case")
+	cmdFilter.Flag.BoolVar(&filterOpt.IgnoreCase, "i", false, "Ignore case")
+	cmdFilter.Flag.BoolVar(&filterOpt.All, "all", false, "All columns should match")
+	cmdFilter.Flag.BoolVar(&filterOpt.All, "a", false, "All columns should match")
+	cmdFilter.Flag.BoolVar(&filterOpt.Invert, "invert", false, "Output unmatched lines")
+	cmdFilter.Flag.BoolVar(&filterOpt.Invert, "v", false, "Output unmatched lines")
 }
 
 // runFilter executes filter command and return exit code.
@@ -106,6 +136,12 @@ func runFilter(args []string) int {
 
 	opt := filterOpt.FilterOption
 	opt.ColumnSyms = split(filterOpt.Column)
+	if filterOpt.PatternsFile != "" {
+		opt.Patterns, err = readLines(filterOpt.PatternsFile)
+		if err != nil {
+			return handleError(err)
+		}
+	}
 	err = csvutil.Filter(r, w, opt)
 	if err != nil {
 		return handleError(err)
@@ -90,3 +90,23 @@ func Test_runFilterOnOverwrite(t *testing.T) {
 		t.Fatalf("Overwrite failed. got %+v", c)
 	}
 }
+
+func Example_runFilterWithPatternsFile() {
+	filterOpt.PatternsFile = testFilePath("patterns.txt")
+	filterOpt.Column = "aaa"
+	filterOpt.Invert = true
+	runFilter([]string{testFilePath("filter.csv")})
+	filterOpt.Invert = false
+	filterOpt.Column = ""
+	filterOpt.PatternsFile = ""
+	// Output: aaa,bbb,ccc
+	// D4,E1,F6
+}
+
+func Test_runFilterOnNoPatternsFile(t *testing.T) {
+	filterOpt.PatternsFile = testFilePath("no-file.txt")
+	if c := runFilter([]string{testFilePath("filter.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	filterOpt.PatternsFile = ""
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+	"github.com/pkg/errors"
+)
+
+var cmdLookup = &Command{
+	Run:       runLookup,
+	UsageLine: "lookup [OPTIONS...] [FILE]",
+	Short:     "参照CSVから値を追加",
+	Long: `DESCRIPTION
+        キー列の値で参照CSVを検索し、見つかった行の指定列の値を末尾の列として追加したCSVを出力します。
+        参照CSVは1行目をヘッダー行として扱い、全件をメモリ上に読み込みます。
+        参照CSVに同じキーが複数存在する場合、最初の行が使用されます。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -k, --key COLUMN_SYMBOL
+            ソースとなるCSVのキー列のシンボルを指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+
+        -r, --ref FILE
+            参照CSVのパスを指定します。
+
+        -re, --ref-encoding ENCODING
+            参照CSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとみなします。
+
+        -rk, --ref-key COLUMN_SYMBOL
+            参照CSVのキー列のシンボルを指定します。
+
+        -t, --take COLUMN_SYMBOL(S)
+            追加する参照CSVの列のシンボルを指定します。追加される列のヘッダーは参照CSVのヘッダーテキストになります。
+            複数列を対象としたい場合は、foo:bar や 1:2のようにコロン区切りで指定して下さい。
+
+        -d, --default TEXT
+            キーが参照CSVに見つからなかった場合に出力する値を指定します。初期値は空文字です。
+
+        -f, --fail-on-miss
+            キーが参照CSVに見つからなかった場合、エラーとして処理を中断します。
+	`,
+}
+
+type cmdLookupOption struct {
+	csvutil.LookupOption
+	// Overwrite to source. (default false)
+	Overwrite bool
+	// Backup source file. (default false)
+	Backup bool
+	// Reference file path.
+	Reference string
+	// Taken column symbols separated by colon.
+	Take string
+}
+
+var lookupOpt = cmdLookupOption{}
+
+func init() {
+	cmdLookup.Flag.BoolVar(&lookupOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdLookup.Flag.BoolVar(&lookupOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdLookup.Flag.BoolVar(&lookupOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdLookup.Flag.BoolVar(&lookupOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdLookup.Flag.BoolVar(&lookupOpt.Backup, "backup", false, "Backup source file.")
+	cmdLookup.Flag.BoolVar(&lookupOpt.Backup, "b", false, "Backup source file.")
+	cmdLookup.Flag.StringVar(&lookupOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdLookup.Flag.StringVar(&lookupOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdLookup.Flag.StringVar(&lookupOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdLookup.Flag.StringVar(&lookupOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdLookup.Flag.StringVar(&lookupOpt.Key, "key", "", "Key column symbol")
+	cmdLookup.Flag.StringVar(&lookupOpt.Key, "k", "", "Key column symbol")
+	cmdLookup.Flag.StringVar(&lookupOpt.Reference, "ref", "", "Reference file path")
+	cmdLookup.Flag.StringVar(&lookupOpt.Reference, "r", "", "Reference file path")
+	cmdLookup.Flag.StringVar(&lookupOpt.ReferenceEncoding, "ref-encoding", "", "Encoding of reference file")
+	cmdLookup.Flag.StringVar(&lookupOpt.ReferenceEncoding, "re", "", "Encoding of reference file")
+	cmdLookup.Flag.StringVar(&lookupOpt.ReferenceKey, "ref-key", "", "Key column symbol of reference file")
+	cmdLookup.Flag.StringVar(&lookupOpt.ReferenceKey, "rk", "", "Key column symbol of reference file")
+	cmdLookup.Flag.StringVar(&lookupOpt.Take, "take", "", "Taken column symbols")
+	cmdLookup.Flag.StringVar(&lookupOpt.Take, "t", "", "Taken column symbols")
+	cmdLookup.Flag.StringVar(&lookupOpt.Default, "default", "", "Default value for missed key")
+	cmdLookup.Flag.StringVar(&lookupOpt.Default, "d", "", "Default value for missed key")
+	cmdLookup.Flag.BoolVar(&lookupOpt.FailOnMiss, "fail-on-miss", false, "Fail on missed key")
+	cmdLookup.Flag.BoolVar(&lookupOpt.FailOnMiss, "f", false, "Fail on missed key")
+}
+
+// runLookup executes lookup command and return exit code.
+func runLookup(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, lookupOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, lookupOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	if lookupOpt.Reference == "" {
+		return handleError(errors.New("no reference file"))
+	}
+	ref, reff, err := reader(lookupOpt.Reference)
+	if reff != nil {
+		defer reff()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := lookupOpt.LookupOption
+	opt.TakeSyms = split(lookupOpt.Take)
+	err = csvutil.Lookup(r, w, ref, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
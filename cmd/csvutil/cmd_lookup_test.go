@@ -0,0 +1,91 @@
+package main
+
+import "testing"
+
+func Example_runLookup() {
+	lookupOpt.Key = "pref_code"
+	lookupOpt.Reference = testFilePath("prefs.csv")
+	lookupOpt.ReferenceKey = "code"
+	lookupOpt.Take = "name:region"
+	lookupOpt.Default = "-"
+	runLookup([]string{testFilePath("members.csv")})
+	lookupOpt.Default = ""
+	lookupOpt.Take = ""
+	lookupOpt.ReferenceKey = ""
+	lookupOpt.Reference = ""
+	lookupOpt.Key = ""
+	// Output: 名前,pref_code,name,region
+	// 山田 太郎,13,東京都,関東
+	// 佐藤 花子,27,大阪府,近畿
+	// 鈴木 一郎,99,-,-
+}
+
+func Test_runLookup(t *testing.T) {
+	lookupOpt.Key = "pref_code"
+	lookupOpt.Reference = testFilePath("prefs.csv")
+	lookupOpt.ReferenceKey = "code"
+	lookupOpt.Take = "name"
+	if c := runLookup([]string{testFilePath("members.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	lookupOpt.Take = ""
+	lookupOpt.ReferenceKey = ""
+	lookupOpt.Reference = ""
+	lookupOpt.Key = ""
+}
+
+func Test_runLookupOnFailOnMiss(t *testing.T) {
+	lookupOpt.Key = "pref_code"
+	lookupOpt.Reference = testFilePath("prefs.csv")
+	lookupOpt.ReferenceKey = "code"
+	lookupOpt.Take = "name"
+	lookupOpt.FailOnMiss = true
+	if c := runLookup([]string{testFilePath("members.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	lookupOpt.FailOnMiss = false
+	lookupOpt.Take = ""
+	lookupOpt.ReferenceKey = ""
+	lookupOpt.Reference = ""
+	lookupOpt.Key = ""
+}
+
+func Test_runLookupWithoutReference(t *testing.T) {
+	lookupOpt.Key = "pref_code"
+	lookupOpt.ReferenceKey = "code"
+	lookupOpt.Take = "name"
+	if c := runLookup([]string{testFilePath("members.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	lookupOpt.Take = ""
+	lookupOpt.ReferenceKey = ""
+	lookupOpt.Key = ""
+}
+
+func Test_runLookupOnNoReferenceFile(t *testing.T) {
+	lookupOpt.Key = "pref_code"
+	lookupOpt.Reference = testFilePath("no-file.csv")
+	lookupOpt.ReferenceKey = "code"
+	lookupOpt.Take = "name"
+	if c := runLookup([]string{testFilePath("members.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	lookupOpt.Take = ""
+	lookupOpt.ReferenceKey = ""
+	lookupOpt.Reference = ""
+	lookupOpt.Key = ""
+}
+
+func Test_runLookupOnNoFile(t *testing.T) {
+	lookupOpt.Key = "pref_code"
+	lookupOpt.Reference = testFilePath("prefs.csv")
+	lookupOpt.ReferenceKey = "code"
+	lookupOpt.Take = "name"
+	if c := runLookup([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	lookupOpt.Take = ""
+	lookupOpt.ReferenceKey = ""
+	lookupOpt.Reference = ""
+	lookupOpt.Key = ""
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdMove = &Command{
+	Run:       runMove,
+	UsageLine: "move [OPTIONS...] [FILE]",
+	Short:     "列移動",
+	Long: `DESCRIPTION
+        指定した列を指定した位置に移動したCSVを出力します。
+        その他の列は元の順序のまま出力されます。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --column COLUMN_SYMBOL(S)
+            移動する列のシンボルを指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+            複数列を対象としたい場合は、foo:bar や 1:2のようにコロン区切りで指定して下さい。
+            複数列を指定した場合、指定した順序で移動します。
+
+        --before COLUMN_SYMBOL
+            指定した列の前に移動します。
+
+        --after COLUMN_SYMBOL
+            指定した列の後に移動します。
+
+        --to-start
+            先頭に移動します。
+
+        --to-end
+            末尾に移動します。
+
+        --before, --after, --to-start, --to-end のいずれか1つを指定して下さい。
+	`,
+}
+
+type cmdMoveOption struct {
+	csvutil.MoveOption
+	Overwrite bool
+	Backup    bool
+	Column    string
+}
+
+var moveOpt = cmdMoveOption{}
+
+func init() {
+	cmdMove.Flag.BoolVar(&moveOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdMove.Flag.BoolVar(&moveOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdMove.Flag.BoolVar(&moveOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdMove.Flag.BoolVar(&moveOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdMove.Flag.BoolVar(&moveOpt.Backup, "backup", false, "Backup source file.")
+	cmdMove.Flag.BoolVar(&moveOpt.Backup, "b", false, "Backup source file.")
+	cmdMove.Flag.StringVar(&moveOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdMove.Flag.StringVar(&moveOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdMove.Flag.StringVar(&moveOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdMove.Flag.StringVar(&moveOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdMove.Flag.StringVar(&moveOpt.Column, "column", "", "Target column symbol")
+	cmdMove.Flag.StringVar(&moveOpt.Column, "c", "", "Target column symbol")
+	cmdMove.Flag.StringVar(&moveOpt.Before, "before", "", "Before column symbol")
+	cmdMove.Flag.StringVar(&moveOpt.After, "after", "", "After column symbol")
+	cmdMove.Flag.BoolVar(&moveOpt.ToStart, "to-start", false, "Move to start")
+	cmdMove.Flag.BoolVar(&moveOpt.ToEnd, "to-end", false, "Move to end")
+}
+
+// runMove executes move command and return exit code.
+func runMove(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, moveOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, moveOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := moveOpt.MoveOption
+	opt.ColumnSyms = split(moveOpt.Column)
+	err = csvutil.Move(r, w, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
@@ -0,0 +1,86 @@
+package main
+
+import "testing"
+
+func Example_runMove() {
+	moveOpt.Column = "個数"
+	moveOpt.ToStart = true
+	runMove([]string{testFilePath("utf8.csv")})
+	moveOpt.ToStart = false
+	moveOpt.Column = ""
+	// Output: 個数,名前
+	// 1,りんご
+	// 2,みかん
+}
+
+func Test_runMove(t *testing.T) {
+	moveOpt.Column = "名前"
+	moveOpt.ToEnd = true
+	if c := runMove([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	moveOpt.ToEnd = false
+	moveOpt.Column = ""
+}
+
+func Test_runMoveOnNoFile(t *testing.T) {
+	moveOpt.Column = "0"
+	moveOpt.ToEnd = true
+	if c := runMove([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	moveOpt.ToEnd = false
+	moveOpt.Column = ""
+}
+
+func Test_runMoveOnFail(t *testing.T) {
+	moveOpt.Column = "0"
+	moveOpt.ToEnd = true
+	if c := runMove([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	moveOpt.ToEnd = false
+	moveOpt.Column = ""
+}
+
+func Test_runMoveOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	moveOpt.Column = "名前"
+	moveOpt.After = "個数"
+	moveOpt.Overwrite = true
+	moveOpt.Backup = true
+	runMove([]string{tempFilePath()})
+	moveOpt.Backup = false
+	moveOpt.Overwrite = false
+	moveOpt.After = ""
+	moveOpt.Column = ""
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runMoveOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	moveOpt.Column = "個数"
+	moveOpt.Before = "名前"
+	moveOpt.Overwrite = true
+	runMove([]string{tempFilePath()})
+	moveOpt.Overwrite = false
+	moveOpt.Before = ""
+	moveOpt.Column = ""
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c) != 3 || c[0][0] != "個数" || c[0][1] != "名前" || c[1][0] != "1" || c[1][1] != "りんご" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
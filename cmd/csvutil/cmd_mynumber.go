@@ -0,0 +1,120 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdMyNumber = &Command{
+	Run:       runMyNumber,
+	UsageLine: "mynumber [OPTIONS...] [FILE]",
+	Short:     "個人番号・法人番号生成",
+	Long: `DESCRIPTION
+        指定した列にダミーの個人番号（12桁）もしくは法人番号（13桁）を出力します。
+        出力される番号は公式の計算方法によるチェックディジットを持ちます。
+        --validate オプションを指定した場合、既存の値のチェックディジットを検証します。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --column COLUMN_SYMBOL
+            対象となる列のシンボルを指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+
+        -cp, --corporate
+            個人番号ではなく法人番号を対象とします。
+
+        -v, --validate
+            ダミーの番号を出力せず、指定した列の値が正しい番号かを検証します。
+            空の値は検証されません。
+            --result-column オプションが指定されていない場合、不正な値が見つかった時点でエラーとなります。
+
+        -r, --result-column COLUMN_SYMBOL
+            検証結果を出力する列のシンボルを指定します。
+            正しい値の場合は valid、不正な値の場合は invalid を出力します。
+            --validate オプションと同時に使用して下さい。
+	`,
+}
+
+type cmdMyNumberOption struct {
+	csvutil.MyNumberOption
+	Overwrite bool
+	Backup    bool
+}
+
+var myNumberOpt = cmdMyNumberOption{}
+
+func init() {
+	cmdMyNumber.Flag.BoolVar(&myNumberOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdMyNumber.Flag.BoolVar(&myNumberOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdMyNumber.Flag.BoolVar(&myNumberOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdMyNumber.Flag.BoolVar(&myNumberOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdMyNumber.Flag.BoolVar(&myNumberOpt.Backup, "backup", false, "Backup source file.")
+	cmdMyNumber.Flag.BoolVar(&myNumberOpt.Backup, "b", false, "Backup source file.")
+	cmdMyNumber.Flag.StringVar(&myNumberOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdMyNumber.Flag.StringVar(&myNumberOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdMyNumber.Flag.StringVar(&myNumberOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdMyNumber.Flag.StringVar(&myNumberOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdMyNumber.Flag.StringVar(&myNumberOpt.Column, "column", "", "Number column symbol")
+	cmdMyNumber.Flag.StringVar(&myNumberOpt.Column, "c", "", "Number column symbol")
+	cmdMyNumber.Flag.BoolVar(&myNumberOpt.Corporate, "corporate", false, "Use corporate number")
+	cmdMyNumber.Flag.BoolVar(&myNumberOpt.Corporate, "cp", false, "Use corporate number")
+	cmdMyNumber.Flag.BoolVar(&myNumberOpt.Validate, "validate", false, "Validate existing values")
+	cmdMyNumber.Flag.BoolVar(&myNumberOpt.Validate, "v", false, "Validate existing values")
+	cmdMyNumber.Flag.StringVar(&myNumberOpt.ResultColumn, "result-column", "", "Validation result column symbol")
+	cmdMyNumber.Flag.StringVar(&myNumberOpt.ResultColumn, "r", "", "Validation result column symbol")
+}
+
+// runMyNumber executes mynumber command and return exit code.
+func runMyNumber(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, myNumberOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, myNumberOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	err = csvutil.MyNumber(r, w, myNumberOpt.MyNumberOption)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+)
+
+func Test_runMyNumber(t *testing.T) {
+	myNumberOpt.Column = "名前"
+	if c := runMyNumber([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	myNumberOpt.Column = ""
+}
+
+func Test_runMyNumberOnNoFile(t *testing.T) {
+	if c := runMyNumber([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runMyNumberOnFail(t *testing.T) {
+	if c := runMyNumber([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runMyNumberOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	myNumberOpt.Column = "名前"
+	myNumberOpt.Overwrite = true
+	myNumberOpt.Backup = true
+	runMyNumber([]string{tempFilePath()})
+	myNumberOpt.Backup = false
+	myNumberOpt.Overwrite = false
+	myNumberOpt.Column = ""
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runMyNumberOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	myNumberOpt.Column = "名前"
+	myNumberOpt.Overwrite = true
+	runMyNumber([]string{tempFilePath()})
+	myNumberOpt.Overwrite = false
+	myNumberOpt.Column = ""
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := regexp.MustCompile(`^\d{12}$`)
+	if len(c[0]) != 2 {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if c[0][0] != "名前" || c[0][1] != "個数" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if !r.MatchString(c[1][0]) || c[1][1] != "1" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if !r.MatchString(c[2][0]) || c[2][1] != "2" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
+
+func Test_runMyNumberOnValidate(t *testing.T) {
+	myNumberOpt.Column = "名前"
+	myNumberOpt.Validate = true
+	if c := runMyNumber([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	myNumberOpt.ResultColumn = "個数"
+	if c := runMyNumber([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	myNumberOpt.ResultColumn = ""
+	myNumberOpt.Validate = false
+	myNumberOpt.Column = ""
+}
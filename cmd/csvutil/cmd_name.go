@@ -66,6 +66,26 @@ OPTIONS
         -h, --hiragana
             このオプションを指定すると仮名をひらがなで出力します。
 
+        -ro, --romaji COLUMN_SYMBOL
+            フルネーム（ローマ字）を出力する列のシンボルを指定します。
+            ローマ字は仮名からヘボン式（パスポート表記）で変換され、姓と名の間は半角スペースで区切られます。
+            長音は表記せず（例: さとう → Sato）、b,m,p の前の「ん」は m と表記します（例: なんば → Namba）。
+
+        -lr, --last-romaji COLUMN_SYMBOL
+            姓（ローマ字）を出力する列のシンボルを指定します。
+
+        -fr, --first-romaji COLUMN_SYMBOL
+            名（ローマ字）を出力する列のシンボルを指定します。
+
+        -rc, --romaji-case CASE
+            ローマ字の大文字・小文字を指定します。初期値は title です。
+                upper: 全て大文字で出力します（例: TARO YAMADA）
+                title: 先頭のみ大文字で出力します（例: Taro Yamada）
+
+        -rl, --romaji-last-name-first
+            フルネーム（ローマ字）を姓→名の順で出力します。（例: Yamada Taro）
+            指定しない場合、名→姓の順で出力します。
+
         -g, --gender COLUMN_SYMBOL
             性別を出力する列のシンボルを指定します。
 
@@ -134,6 +154,16 @@ func init() {
 	cmdName.Flag.StringVar(&nameOpt.LastKana, "lk", "", "Last kana column symbol")
 	cmdName.Flag.BoolVar(&nameOpt.Hiragana, "hiragana", false, "Output hiragana as kana")
 	cmdName.Flag.BoolVar(&nameOpt.Hiragana, "h", false, "Output hiragana as kana")
+	cmdName.Flag.StringVar(&nameOpt.Romaji, "romaji", "", "Romaji column symbol")
+	cmdName.Flag.StringVar(&nameOpt.Romaji, "ro", "", "Romaji column symbol")
+	cmdName.Flag.StringVar(&nameOpt.FirstRomaji, "first-romaji", "", "First romaji column symbol")
+	cmdName.Flag.StringVar(&nameOpt.FirstRomaji, "fr", "", "First romaji column symbol")
+	cmdName.Flag.StringVar(&nameOpt.LastRomaji, "last-romaji", "", "Last romaji column symbol")
+	cmdName.Flag.StringVar(&nameOpt.LastRomaji, "lr", "", "Last romaji column symbol")
+	cmdName.Flag.StringVar(&nameOpt.RomajiCase, "romaji-case", "title", "Romaji case")
+	cmdName.Flag.StringVar(&nameOpt.RomajiCase, "rc", "title", "Romaji case")
+	cmdName.Flag.BoolVar(&nameOpt.RomajiLastNameFirst, "romaji-last-name-first", false, "Output romaji in order of last name and first name")
+	cmdName.Flag.BoolVar(&nameOpt.RomajiLastNameFirst, "rl", false, "Output romaji in order of last name and first name")
 	cmdName.Flag.StringVar(&nameOpt.Gender, "gender", "", "Gender column symbol")
 	cmdName.Flag.StringVar(&nameOpt.Gender, "g", "", "Gender column symbol")
 	cmdName.Flag.IntVar(&nameOpt.MaleRate, "male-rate", 50, "Male rate")
@@ -23,6 +23,19 @@ func Test_runName(t *testing.T) {
 	nameOpt.Name = ""
 }
 
+func Test_runNameOnRomaji(t *testing.T) {
+	nameOpt.Romaji = "名前"
+	if c := runName([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	nameOpt.RomajiCase = "foo"
+	if c := runName([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	nameOpt.RomajiCase = "title"
+	nameOpt.Romaji = ""
+}
+
 func Test_runNameOnNoFile(t *testing.T) {
 	nameOpt.Name = "0"
 	if c := runName([]string{testFilePath("no-file.csv")}); c == 0 {
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdNormalize = &Command{
+	Run:       runNormalize,
+	UsageLine: "normalize [OPTIONS...] [FILE]",
+	Short:     "文字正規化",
+	Long: `DESCRIPTION
+        指定した列の文字幅やかなを正規化したCSVを出力します。
+        複数の正規化が指定された場合、NFKC、ひらがな・カタカナ、カナの幅、数字の幅、英字の幅、ハイフンの順で適用します。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --column COLUMN_SYMBOL(S)
+            対象となる列のシンボルを指定します。指定されていない場合、全ての列が対象になります。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+            複数列を対象としたい場合は、foo:bar や 1:2のようにコロン区切りで指定して下さい。
+
+        --nfkc
+            Unicode の NFKC 正規化を行います。
+            半角カナは全角に、全角英数字は半角に、㈱ などの文字は (株) のように変換されます。
+
+        -k, --kana WIDTH
+            カタカナの幅を変換します。濁点・半濁点も合わせて変換します。
+            対応している値:
+                zenkaku: 半角カナを全角カナに変換します（ｶﾞ → ガ）
+                hankaku: 全角カナを半角カナに変換します（ガ → ｶﾞ）
+
+        -hk, --hiragana-to-katakana
+            ひらがなをカタカナに変換します。
+
+        -kh, --katakana-to-hiragana
+            カタカナをひらがなに変換します。--hiragana-to-katakana オプションと同時に指定することはできません。
+
+        -d, --digits WIDTH
+            数字の幅を変換します。
+            対応している値:
+                zenkaku: 半角数字を全角数字に変換します
+                hankaku: 全角数字を半角数字に変換します
+
+        -al, --alphabets WIDTH
+            英字の幅を変換します。対応している値は --digits オプションと同じです。
+
+        -hy, --hyphen
+            ‐ － − ― などのハイフンに類する文字を半角のハイフンマイナス（-）に統一します。
+            長音記号（ー）は英数字の直後にある場合のみハイフンに変換します。
+	`,
+}
+
+type cmdNormalizeOption struct {
+	csvutil.NormalizeOption
+	// Overwrite to source. (default false)
+	Overwrite bool
+	// Backup source file. (default false)
+	Backup bool
+	// Column header or column index separated by colon.
+	Column string
+}
+
+var normalizeOpt = cmdNormalizeOption{}
+
+func init() {
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.Backup, "backup", false, "Backup source file.")
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.Backup, "b", false, "Backup source file.")
+	cmdNormalize.Flag.StringVar(&normalizeOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdNormalize.Flag.StringVar(&normalizeOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdNormalize.Flag.StringVar(&normalizeOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdNormalize.Flag.StringVar(&normalizeOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdNormalize.Flag.StringVar(&normalizeOpt.Column, "column", "", "Target column symbol")
+	cmdNormalize.Flag.StringVar(&normalizeOpt.Column, "c", "", "Target column symbol")
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.NFKC, "nfkc", false, "Apply NFKC normalization")
+	cmdNormalize.Flag.StringVar(&normalizeOpt.Kana, "kana", "", "Width of katakana")
+	cmdNormalize.Flag.StringVar(&normalizeOpt.Kana, "k", "", "Width of katakana")
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.HiraganaToKatakana, "hiragana-to-katakana", false, "Convert hiragana to katakana")
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.HiraganaToKatakana, "hk", false, "Convert hiragana to katakana")
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.KatakanaToHiragana, "katakana-to-hiragana", false, "Convert katakana to hiragana")
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.KatakanaToHiragana, "kh", false, "Convert katakana to hiragana")
+	cmdNormalize.Flag.StringVar(&normalizeOpt.Digits, "digits", "", "Width of digits")
+	cmdNormalize.Flag.StringVar(&normalizeOpt.Digits, "d", "", "Width of digits")
+	cmdNormalize.Flag.StringVar(&normalizeOpt.Alphabets, "alphabets", "", "Width of alphabets")
+	cmdNormalize.Flag.StringVar(&normalizeOpt.Alphabets, "al", "", "Width of alphabets")
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.Hyphen, "hyphen", false, "Unify hyphens")
+	cmdNormalize.Flag.BoolVar(&normalizeOpt.Hyphen, "hy", false, "Unify hyphens")
+}
+
+// runNormalize executes normalize command and return exit code.
+func runNormalize(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, normalizeOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, normalizeOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := normalizeOpt.NormalizeOption
+	opt.ColumnSyms = split(normalizeOpt.Column)
+	err = csvutil.Normalize(r, w, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
@@ -0,0 +1,57 @@
+package main
+
+import "testing"
+
+func Example_runNormalize() {
+	normalizeOpt.Column = "名前"
+	normalizeOpt.Kana = "hankaku"
+	normalizeOpt.HiraganaToKatakana = true
+	runNormalize([]string{testFilePath("utf8.csv")})
+	normalizeOpt.HiraganaToKatakana = false
+	normalizeOpt.Kana = ""
+	normalizeOpt.Column = ""
+	// Output: 名前,個数
+	// ﾘﾝｺﾞ,1
+	// ﾐｶﾝ,2
+}
+
+func Example_runNormalizeWithDigits() {
+	normalizeOpt.Digits = "zenkaku"
+	runNormalize([]string{testFilePath("utf8.csv")})
+	normalizeOpt.Digits = ""
+	// Output: 名前,個数
+	// りんご,１
+	// みかん,２
+}
+
+func Test_runNormalize(t *testing.T) {
+	normalizeOpt.NFKC = true
+	if c := runNormalize([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	normalizeOpt.NFKC = false
+}
+
+func Test_runNormalizeOnInvalidWidth(t *testing.T) {
+	normalizeOpt.Kana = "foo"
+	if c := runNormalize([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	normalizeOpt.Kana = ""
+}
+
+func Test_runNormalizeOnNoFile(t *testing.T) {
+	normalizeOpt.NFKC = true
+	if c := runNormalize([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	normalizeOpt.NFKC = false
+}
+
+func Test_runNormalizeOnFail(t *testing.T) {
+	normalizeOpt.NFKC = true
+	if c := runNormalize([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	normalizeOpt.NFKC = false
+}
@@ -10,6 +10,8 @@ var cmdNumeric = &Command{
 	Short:     "数値生成",
 	Long: `DESCRIPTION
         指定した列にmin <= n < max となるランダムな数値を出力します。
+        --inclusive-max オプションを指定した場合は min <= n <= max となります。
+        --distribution オプションで一様分布以外の分布に従う数値を出力できます。
 
 ARGUMENTS
         FILE
@@ -51,16 +53,51 @@ OPTIONS
             --no-header オプションが指定された場合、インデックスしか受け入れません。
 
         -mx, --max NUMBER
-            出力する数値の最大値を指定します。ただし、ここで指定した値は出力されません。
+            出力する数値の最大値を指定します。小数も指定できます。（初期値: 100）
+            ただし、--inclusive-max オプションが指定されていない場合、ここで指定した値は出力されません。
 
         -mn, --min NUMBER
-            出力する数値の最小値を指定します。
+            出力する数値の最小値を指定します。小数も指定できます。（初期値: 0）
+
+        -im, --inclusive-max
+            --max オプションで指定した値も出力の対象にします。
 
         -d, --decimal
             出力する数値を小数として出力します。
 
         -dd, --decimal-digit NUMBER
             出力する小数の有効桁数を指定します。値は正の整数でなければいけません。（初期値: 3）
+
+        -dist, --distribution DISTRIBUTION
+            出力する数値が従う分布を指定します。
+            一様分布以外の場合、min, max の範囲外の数値は再抽選されます。
+            対応している値:
+                uniform    : 一様分布（初期値）
+                normal     : 正規分布（--mean, --stddev が必要です）
+                lognormal  : 対数正規分布（--mean, --stddev には対数の平均・標準偏差を指定します）
+                exponential: 指数分布（--mean が必要です）
+                poisson    : ポアソン分布（--mean が必要です）
+
+        --mean NUMBER
+            分布の平均を指定します。
+
+        -sd, --stddev NUMBER
+            分布の標準偏差を指定します。
+
+        -st, --step NUMBER
+            出力する数値をこの値の倍数に丸めます。100 を指定すると 100 円単位の金額のようになります。
+
+        -ts, --thousands-separator
+            整数部に3桁ごとのカンマを挿入します。
+
+        --prefix TEXT
+            数値の前に付加する文字列を指定します。（例: ¥, $）
+
+        --suffix TEXT
+            数値の後ろに付加する文字列を指定します。（例: 円）
+
+        -er, --empty-rate RATE
+            空文字を出力する確率を0から100の整数で指定します。（初期値: 0）
 	`,
 }
 
@@ -85,14 +122,29 @@ func init() {
 	cmdNumeric.Flag.StringVar(&numericOpt.OutputEncoding, "oe", "", "Encoding for output")
 	cmdNumeric.Flag.StringVar(&numericOpt.Column, "column", "", "Target column symbol")
 	cmdNumeric.Flag.StringVar(&numericOpt.Column, "c", "", "Target column symbol")
-	cmdNumeric.Flag.IntVar(&numericOpt.Max, "max", 100, "Maximum value")
-	cmdNumeric.Flag.IntVar(&numericOpt.Max, "mx", 100, "Maximum value")
-	cmdNumeric.Flag.IntVar(&numericOpt.Min, "min", 0, "Minimum value")
-	cmdNumeric.Flag.IntVar(&numericOpt.Min, "mn", 0, "Minmum value")
+	cmdNumeric.Flag.Float64Var(&numericOpt.MaxFloat, "max", 100, "Maximum value")
+	cmdNumeric.Flag.Float64Var(&numericOpt.MaxFloat, "mx", 100, "Maximum value")
+	cmdNumeric.Flag.Float64Var(&numericOpt.MinFloat, "min", 0, "Minimum value")
+	cmdNumeric.Flag.Float64Var(&numericOpt.MinFloat, "mn", 0, "Minmum value")
+	cmdNumeric.Flag.BoolVar(&numericOpt.InclusiveMax, "inclusive-max", false, "Include maximum value")
+	cmdNumeric.Flag.BoolVar(&numericOpt.InclusiveMax, "im", false, "Include maximum value")
 	cmdNumeric.Flag.BoolVar(&numericOpt.Decimal, "decimal", false, "Output decimal number")
 	cmdNumeric.Flag.BoolVar(&numericOpt.Decimal, "d", false, "Output decimal number")
 	cmdNumeric.Flag.IntVar(&numericOpt.DecimalDigit, "decimal-digit", 3, "Decimal digit number")
 	cmdNumeric.Flag.IntVar(&numericOpt.DecimalDigit, "dd", 3, "Decimal digit number")
+	cmdNumeric.Flag.StringVar(&numericOpt.Distribution, "distribution", "uniform", "Distribution")
+	cmdNumeric.Flag.StringVar(&numericOpt.Distribution, "dist", "uniform", "Distribution")
+	cmdNumeric.Flag.Float64Var(&numericOpt.Mean, "mean", 0, "Mean")
+	cmdNumeric.Flag.Float64Var(&numericOpt.StdDev, "stddev", 0, "Standard deviation")
+	cmdNumeric.Flag.Float64Var(&numericOpt.StdDev, "sd", 0, "Standard deviation")
+	cmdNumeric.Flag.Float64Var(&numericOpt.Step, "step", 0, "Step for rounding")
+	cmdNumeric.Flag.Float64Var(&numericOpt.Step, "st", 0, "Step for rounding")
+	cmdNumeric.Flag.BoolVar(&numericOpt.ThousandsSeparator, "thousands-separator", false, "Insert thousands separators")
+	cmdNumeric.Flag.BoolVar(&numericOpt.ThousandsSeparator, "ts", false, "Insert thousands separators")
+	cmdNumeric.Flag.StringVar(&numericOpt.Prefix, "prefix", "", "Prefix of number")
+	cmdNumeric.Flag.StringVar(&numericOpt.Suffix, "suffix", "", "Suffix of number")
+	cmdNumeric.Flag.IntVar(&numericOpt.EmptyRate, "empty-rate", 0, "Rate of empty value")
+	cmdNumeric.Flag.IntVar(&numericOpt.EmptyRate, "er", 0, "Rate of empty value")
 }
 
 // runNumeric executes numeric command and return exit code.
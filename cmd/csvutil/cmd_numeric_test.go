@@ -75,3 +75,36 @@ func Test_runNumericOnOverwrite(t *testing.T) {
 		t.Fatalf("Overwrite failed. got %+v", c)
 	}
 }
+
+func Example_runNumericWithFormat() {
+	numericOpt.Column = "個数"
+	numericOpt.MinFloat = 1234567
+	numericOpt.MaxFloat = 1234567
+	numericOpt.InclusiveMax = true
+	numericOpt.ThousandsSeparator = true
+	numericOpt.Prefix = "¥"
+	runNumeric([]string{testFilePath("utf8.csv")})
+	numericOpt.Prefix = ""
+	numericOpt.ThousandsSeparator = false
+	numericOpt.InclusiveMax = false
+	numericOpt.MaxFloat = 100
+	numericOpt.MinFloat = 0
+	numericOpt.Column = ""
+	// Output: 名前,個数
+	// りんご,"¥1,234,567"
+	// みかん,"¥1,234,567"
+}
+
+func Test_runNumericWithDistribution(t *testing.T) {
+	numericOpt.Column = "個数"
+	numericOpt.Distribution = "normal"
+	numericOpt.Mean = 50
+	numericOpt.StdDev = 10
+	if c := runNumeric([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	numericOpt.StdDev = 0
+	numericOpt.Mean = 0
+	numericOpt.Distribution = "uniform"
+	numericOpt.Column = ""
+}
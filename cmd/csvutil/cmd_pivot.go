@@ -0,0 +1,130 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdPivot = &Command{
+	Run:       runPivot,
+	UsageLine: "pivot [OPTIONS...] [FILE]",
+	Short:     "縦横変換",
+	Long: `DESCRIPTION
+        縦持ちのCSVを横持ちのCSVに変換して出力します。
+        --column オプションで指定した列の値がヘッダーになり、--value オプションで指定した列の値が集計されます。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -r, --row COLUMN_SYMBOL(S)
+            行のキーとなる列のシンボルを指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+            複数列を対象としたい場合は、foo:bar や 1:2のようにコロン区切りで指定して下さい。
+
+        -c, --column COLUMN_SYMBOL
+            値がヘッダーになる列のシンボルを指定します。
+            ヘッダーは値が出現した順に並びます。
+
+        -v, --value COLUMN_SYMBOL
+            集計する値を持つ列のシンボルを指定します。
+
+        -a, --agg, --aggregation METHOD
+            集計方法を指定します。
+            対応している値:
+                sum   : 合計します（初期値）
+                count : 件数を数えます
+                min   : 最小値を出力します
+                max   : 最大値を出力します
+                avg   : 平均値を出力します
+                first : 最初の値を出力します
+                last  : 最後の値を出力します
+            sum, min, max, avg の場合、空文字は無視されます。また数値でない値があるとエラーになります。
+	`,
+}
+
+type cmdPivotOption struct {
+	csvutil.PivotOption
+	Overwrite bool
+	Backup    bool
+	Row       string
+}
+
+var pivotOpt = cmdPivotOption{}
+
+func init() {
+	cmdPivot.Flag.BoolVar(&pivotOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdPivot.Flag.BoolVar(&pivotOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdPivot.Flag.BoolVar(&pivotOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdPivot.Flag.BoolVar(&pivotOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdPivot.Flag.BoolVar(&pivotOpt.Backup, "backup", false, "Backup source file.")
+	cmdPivot.Flag.BoolVar(&pivotOpt.Backup, "b", false, "Backup source file.")
+	cmdPivot.Flag.StringVar(&pivotOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdPivot.Flag.StringVar(&pivotOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdPivot.Flag.StringVar(&pivotOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdPivot.Flag.StringVar(&pivotOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdPivot.Flag.StringVar(&pivotOpt.Row, "row", "", "Row column symbol")
+	cmdPivot.Flag.StringVar(&pivotOpt.Row, "r", "", "Row column symbol")
+	cmdPivot.Flag.StringVar(&pivotOpt.Column, "column", "", "Header column symbol")
+	cmdPivot.Flag.StringVar(&pivotOpt.Column, "c", "", "Header column symbol")
+	cmdPivot.Flag.StringVar(&pivotOpt.Value, "value", "", "Value column symbol")
+	cmdPivot.Flag.StringVar(&pivotOpt.Value, "v", "", "Value column symbol")
+	cmdPivot.Flag.StringVar(&pivotOpt.Aggregation, "agg", "sum", "Aggregation method")
+	cmdPivot.Flag.StringVar(&pivotOpt.Aggregation, "aggregation", "sum", "Aggregation method")
+	cmdPivot.Flag.StringVar(&pivotOpt.Aggregation, "a", "sum", "Aggregation method")
+}
+
+// runPivot executes pivot command and return exit code.
+func runPivot(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, pivotOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, pivotOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := pivotOpt.PivotOption
+	opt.RowSyms = split(pivotOpt.Row)
+	err = csvutil.Pivot(r, w, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
@@ -0,0 +1,101 @@
+package main
+
+import "testing"
+
+func Example_runPivot() {
+	pivotOpt.Row = "store"
+	pivotOpt.Column = "month"
+	pivotOpt.Value = "amount"
+	runPivot([]string{testFilePath("sales.csv")})
+	pivotOpt.Value = ""
+	pivotOpt.Column = ""
+	pivotOpt.Row = ""
+	// Output: store,2024-01,2024-02
+	// A,150,200
+	// B,300,
+}
+
+func Test_runPivot(t *testing.T) {
+	pivotOpt.Row = "store"
+	pivotOpt.Column = "month"
+	pivotOpt.Value = "amount"
+	if c := runPivot([]string{testFilePath("sales.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	pivotOpt.Value = ""
+	pivotOpt.Column = ""
+	pivotOpt.Row = ""
+}
+
+func Test_runPivotOnNoFile(t *testing.T) {
+	pivotOpt.Row = "0"
+	pivotOpt.Column = "1"
+	pivotOpt.Value = "1"
+	if c := runPivot([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	pivotOpt.Value = ""
+	pivotOpt.Column = ""
+	pivotOpt.Row = ""
+}
+
+func Test_runPivotOnFail(t *testing.T) {
+	pivotOpt.Row = "0"
+	pivotOpt.Column = "1"
+	pivotOpt.Value = "1"
+	if c := runPivot([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	pivotOpt.Value = ""
+	pivotOpt.Column = ""
+	pivotOpt.Row = ""
+}
+
+func Test_runPivotOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pivotOpt.Row = "名前"
+	pivotOpt.Column = "名前"
+	pivotOpt.Value = "個数"
+	pivotOpt.Overwrite = true
+	pivotOpt.Backup = true
+	runPivot([]string{tempFilePath()})
+	pivotOpt.Backup = false
+	pivotOpt.Overwrite = false
+	pivotOpt.Value = ""
+	pivotOpt.Column = ""
+	pivotOpt.Row = ""
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runPivotOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pivotOpt.Row = "名前"
+	pivotOpt.Column = "名前"
+	pivotOpt.Value = "個数"
+	pivotOpt.Overwrite = true
+	runPivot([]string{tempFilePath()})
+	pivotOpt.Overwrite = false
+	pivotOpt.Value = ""
+	pivotOpt.Column = ""
+	pivotOpt.Row = ""
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c) != 3 || len(c[0]) != 3 || c[0][0] != "名前" || c[0][1] != "りんご" || c[0][2] != "みかん" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if c[1][0] != "りんご" || c[1][1] != "1" || c[1][2] != "" || c[2][2] != "2" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pinzolo/csvutil"
+	"github.com/pkg/errors"
+)
+
+var cmdRename = &Command{
+	Run:       runRename,
+	UsageLine: "rename [OPTIONS...] [FILE]",
+	Short:     "ヘッダー変更",
+	Long: `DESCRIPTION
+        ヘッダーテキストを変更したCSVを出力します。
+        指定しなかった列のヘッダーテキストはそのまま出力されます。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -m, --map RULE(S)
+            ヘッダーテキストの変更ルールを指定します。
+            old:new のように変更前の列のシンボルと変更後のヘッダーテキストをコロン区切りで指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            s/^col_// のように s と区切り文字で始まるルールは sed 形式の置換と見なされ、すべてのヘッダーテキストに適用されます。
+            区切り文字には s|^col_|| のように / 以外の記号も使用できます。
+            置換後の文字列では \1 のようにキャプチャグループを参照できます。
+            フラグとして g（すべて置換）と i（大文字小文字を区別しない）が使用できます。
+            複数のルールを指定する場合は、foo:bar,s/^col_// のようにカンマ区切りで指定して下さい。
+            s/x{1,2}// のように sed 形式の置換に含まれるカンマは区切りとして扱いません。
+	`,
+}
+
+type cmdRenameOption struct {
+	csvutil.RenameOption
+	Overwrite bool
+	Backup    bool
+	Map       string
+}
+
+var renameOpt = cmdRenameOption{}
+
+func init() {
+	cmdRename.Flag.BoolVar(&renameOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdRename.Flag.BoolVar(&renameOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdRename.Flag.BoolVar(&renameOpt.Backup, "backup", false, "Backup source file.")
+	cmdRename.Flag.BoolVar(&renameOpt.Backup, "b", false, "Backup source file.")
+	cmdRename.Flag.StringVar(&renameOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdRename.Flag.StringVar(&renameOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdRename.Flag.StringVar(&renameOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdRename.Flag.StringVar(&renameOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdRename.Flag.StringVar(&renameOpt.Map, "map", "", "Rename rules")
+	cmdRename.Flag.StringVar(&renameOpt.Map, "m", "", "Rename rules")
+}
+
+// runRename executes rename command and return exit code.
+func runRename(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, renameOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, renameOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := renameOpt.RenameOption
+	opt.Map, opt.Expressions, err = parseRenameRules(renameOpt.Map)
+	if err != nil {
+		return handleError(err)
+	}
+	err = csvutil.Rename(r, w, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
+
+func parseRenameRules(s string) (map[string]string, []string, error) {
+	if s == "" {
+		return nil, nil, nil
+	}
+	m := make(map[string]string)
+	var exprs []string
+	for _, rule := range splitRenameRules(s) {
+		if renameExprLength(rule) == len(rule) {
+			exprs = append(exprs, rule)
+			continue
+		}
+		kv := strings.SplitN(rule, ":", 2)
+		if len(kv) != 2 {
+			return nil, nil, errors.Errorf("invalid rename rule: %s", rule)
+		}
+		m[kv[0]] = kv[1]
+	}
+	return m, exprs, nil
+}
+
+// splitRenameRules splits rules by comma, but commas in sed style expressions are not treated as separator.
+func splitRenameRules(s string) []string {
+	var rules []string
+	for {
+		n := renameExprLength(s)
+		if n == 0 {
+			n = strings.Index(s, ",")
+		}
+		if n == -1 {
+			return append(rules, s)
+		}
+		rules = append(rules, s[:n])
+		if n == len(s) {
+			return rules
+		}
+		s = s[n+1:]
+	}
+}
+
+// renameExprLength returns length of sed style expression at the head of s.
+// If s does not start with sed style expression, returns 0.
+func renameExprLength(s string) int {
+	if len(s) < 4 || s[0] != 's' {
+		return 0
+	}
+	d, size := utf8.DecodeRuneInString(s[1:])
+	if unicode.IsLetter(d) || unicode.IsDigit(d) || unicode.IsSpace(d) || d == ',' || d == ':' || d == '\\' {
+		return 0
+	}
+	i := 1 + size
+	for n := 0; n < 2; n++ {
+		j := strings.IndexRune(s[i:], d)
+		if j == -1 {
+			return 0
+		}
+		i += j + size
+	}
+	for ; i < len(s) && s[i] != ','; i++ {
+		if s[i] < 'a' || 'z' < s[i] {
+			return 0
+		}
+	}
+	return i
+}
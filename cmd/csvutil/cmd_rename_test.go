@@ -0,0 +1,100 @@
+package main
+
+import "testing"
+
+func Example_runRename() {
+	renameOpt.Map = "名前:品名,s/数/量/"
+	runRename([]string{testFilePath("utf8.csv")})
+	renameOpt.Map = ""
+	// Output: 品名,個量
+	// りんご,1
+	// みかん,2
+}
+
+func Example_runRenameWithCommaInExpression() {
+	renameOpt.Map = "s/数{1,2}/量/,0:品名"
+	runRename([]string{testFilePath("utf8.csv")})
+	renameOpt.Map = ""
+	// Output: 品名,個量
+	// りんご,1
+	// みかん,2
+}
+
+func Example_runRenameWithOtherDelimiter() {
+	renameOpt.Map = "s|^名|品|,s#数$#量#"
+	runRename([]string{testFilePath("utf8.csv")})
+	renameOpt.Map = ""
+	// Output: 品前,個量
+	// りんご,1
+	// みかん,2
+}
+
+func Test_runRename(t *testing.T) {
+	renameOpt.Map = "0:品名"
+	if c := runRename([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	renameOpt.Map = ""
+}
+
+func Test_runRenameOnInvalidRule(t *testing.T) {
+	renameOpt.Map = "名前"
+	if c := runRename([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	renameOpt.Map = ""
+}
+
+func Test_runRenameOnNoFile(t *testing.T) {
+	renameOpt.Map = "0:品名"
+	if c := runRename([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	renameOpt.Map = ""
+}
+
+func Test_runRenameOnFail(t *testing.T) {
+	renameOpt.Map = "0:品名"
+	if c := runRename([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	renameOpt.Map = ""
+}
+
+func Test_runRenameOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	renameOpt.Map = "0:品名"
+	renameOpt.Overwrite = true
+	renameOpt.Backup = true
+	runRename([]string{tempFilePath()})
+	renameOpt.Backup = false
+	renameOpt.Overwrite = false
+	renameOpt.Map = ""
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runRenameOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	renameOpt.Map = "0:品名"
+	renameOpt.Overwrite = true
+	runRename([]string{tempFilePath()})
+	renameOpt.Overwrite = false
+	renameOpt.Map = ""
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c) != 3 || c[0][0] != "品名" || c[0][1] != "個数" || c[1][0] != "りんご" || c[2][1] != "2" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
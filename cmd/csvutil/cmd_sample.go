@@ -0,0 +1,132 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdSample = &Command{
+	Run:       runSample,
+	UsageLine: "sample [OPTIONS...] [FILE]",
+	Short:     "無作為抽出",
+	Long: `DESCRIPTION
+        無作為に抽出した行だけのCSVを出力します。
+        抽出された行は元の順序で出力されます。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --count NUMBER
+            抽出する行の数を指定します。
+            すべての行を読み込みながら抽出するため、巨大なファイルでも使用できます。
+
+        -r, --rate PERCENTAGE
+            抽出する割合を 5 や 5% のように指定します。0〜100までの数値を指定して下さい。
+            --stratify オプションが指定されていない場合、それぞれの行が指定した割合で抽出されるため、出力される行の数は一定ではありません。
+            --count オプションと同時には指定できません。
+
+        -s, --stratify COLUMN_SYMBOL
+            層別抽出に使用する列のシンボルを指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+            --count オプションと同時に指定した場合、列の値ごとに指定した数の行を抽出します。
+            --rate オプションと同時に指定した場合、列の値ごとに行数に割合を掛けて四捨五入した数の行を抽出します。
+
+        --seed NUMBER
+            乱数のシード値を指定します。同じシード値を指定すると同じ結果が得られます。
+            このオプションを指定しない場合、実行ごとに異なる結果になります。
+	`,
+}
+
+type cmdSampleOption struct {
+	csvutil.SampleOption
+	Overwrite bool
+	Backup    bool
+	// Sampling rate text. (e.g. 5%)
+	RateText string
+}
+
+var sampleOpt = cmdSampleOption{}
+
+func init() {
+	cmdSample.Flag.BoolVar(&sampleOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdSample.Flag.BoolVar(&sampleOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdSample.Flag.BoolVar(&sampleOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdSample.Flag.BoolVar(&sampleOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdSample.Flag.BoolVar(&sampleOpt.Backup, "backup", false, "Backup source file.")
+	cmdSample.Flag.BoolVar(&sampleOpt.Backup, "b", false, "Backup source file.")
+	cmdSample.Flag.StringVar(&sampleOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdSample.Flag.StringVar(&sampleOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdSample.Flag.StringVar(&sampleOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdSample.Flag.StringVar(&sampleOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdSample.Flag.IntVar(&sampleOpt.Count, "count", 0, "Sampling line count")
+	cmdSample.Flag.IntVar(&sampleOpt.Count, "c", 0, "Sampling line count")
+	cmdSample.Flag.StringVar(&sampleOpt.RateText, "rate", "", "Sampling rate")
+	cmdSample.Flag.StringVar(&sampleOpt.RateText, "r", "", "Sampling rate")
+	cmdSample.Flag.StringVar(&sampleOpt.Column, "stratify", "", "Stratify column symbol")
+	cmdSample.Flag.StringVar(&sampleOpt.Column, "s", "", "Stratify column symbol")
+	cmdSample.Flag.Int64Var(&sampleOpt.Seed, "seed", 0, "Random seed")
+}
+
+// runSample executes sample command and return exit code.
+func runSample(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, sampleOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, sampleOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := sampleOpt.SampleOption
+	if sampleOpt.RateText != "" {
+		opt.Rate, err = strconv.ParseFloat(strings.TrimSuffix(sampleOpt.RateText, "%"), 64)
+		if err != nil {
+			return handleError(err)
+		}
+	}
+	err = csvutil.Sample(r, w, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
@@ -0,0 +1,82 @@
+package main
+
+import "testing"
+
+func Example_runSample() {
+	sampleOpt.RateText = "100%"
+	runSample([]string{testFilePath("utf8.csv")})
+	sampleOpt.RateText = ""
+	// Output: 名前,個数
+	// りんご,1
+	// みかん,2
+}
+
+func Test_runSample(t *testing.T) {
+	sampleOpt.Count = 1
+	if c := runSample([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	sampleOpt.Count = 0
+}
+
+func Test_runSampleOnInvalidRate(t *testing.T) {
+	sampleOpt.RateText = "foo%"
+	if c := runSample([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	sampleOpt.RateText = ""
+}
+
+func Test_runSampleOnNoFile(t *testing.T) {
+	sampleOpt.Count = 1
+	if c := runSample([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	sampleOpt.Count = 0
+}
+
+func Test_runSampleOnFail(t *testing.T) {
+	sampleOpt.Count = 1
+	if c := runSample([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	sampleOpt.Count = 0
+}
+
+func Test_runSampleOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sampleOpt.Count = 1
+	sampleOpt.Overwrite = true
+	sampleOpt.Backup = true
+	runSample([]string{tempFilePath()})
+	sampleOpt.Backup = false
+	sampleOpt.Overwrite = false
+	sampleOpt.Count = 0
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runSampleOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sampleOpt.Count = 1
+	sampleOpt.Overwrite = true
+	runSample([]string{tempFilePath()})
+	sampleOpt.Overwrite = false
+	sampleOpt.Count = 0
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c) != 2 || len(c[0]) != 2 || c[0][0] != "名前" || c[0][1] != "個数" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdSeparate = &Command{
+	Run:       runSeparate,
+	UsageLine: "separate [OPTIONS...] [FILE]",
+	Short:     "列分割",
+	Long: `DESCRIPTION
+        指定した列の値を複数の列に分割したCSVを出力します。
+        分割された列は元の列の位置に出力されます。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --column COLUMN_SYMBOL
+            分割する列のシンボルを指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+
+        -i, --into HEADER(S)
+            分割後の列のヘッダーテキストを指定します。
+            複数のヘッダーテキストを指定する場合には、foo:bar のようにコロン区切りにします。
+            指定したヘッダーテキストの数だけ列が作成されます。
+
+        -dl, --delimiter TEXT
+            分割時にデリミタとする文字列を指定します。
+            分割後の値が列の数より多い場合、残りの値はすべて最後の列に出力されます。
+
+        -re, --regex, --regexp PATTERN
+            分割に使用するキャプチャグループを持つ正規表現を指定します。
+            キャプチャグループの数は --into オプションで指定した列の数と一致している必要があります。
+            マッチしなかった場合、分割後の値はすべて空文字になります。
+
+        -a, --address
+            値を住所と見なし、都道府県・市区町村・町域の3列に分割します。
+
+        -k, --keep
+            このオプションを指定すると、分割元の列を残します。
+	`,
+}
+
+type cmdSeparateOption struct {
+	csvutil.SeparateOption
+	Overwrite bool
+	Backup    bool
+	Into      string
+}
+
+var separateOpt = cmdSeparateOption{}
+
+func init() {
+	cmdSeparate.Flag.BoolVar(&separateOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdSeparate.Flag.BoolVar(&separateOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdSeparate.Flag.BoolVar(&separateOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdSeparate.Flag.BoolVar(&separateOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdSeparate.Flag.BoolVar(&separateOpt.Backup, "backup", false, "Backup source file.")
+	cmdSeparate.Flag.BoolVar(&separateOpt.Backup, "b", false, "Backup source file.")
+	cmdSeparate.Flag.StringVar(&separateOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdSeparate.Flag.StringVar(&separateOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdSeparate.Flag.StringVar(&separateOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdSeparate.Flag.StringVar(&separateOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdSeparate.Flag.StringVar(&separateOpt.Column, "column", "", "Target column symbol")
+	cmdSeparate.Flag.StringVar(&separateOpt.Column, "c", "", "Target column symbol")
+	cmdSeparate.Flag.StringVar(&separateOpt.Into, "into", "", "Separated column headers")
+	cmdSeparate.Flag.StringVar(&separateOpt.Into, "i", "", "Separated column headers")
+	cmdSeparate.Flag.StringVar(&separateOpt.Delimiter, "delimiter", "", "Delimiter")
+	cmdSeparate.Flag.StringVar(&separateOpt.Delimiter, "dl", "", "Delimiter")
+	cmdSeparate.Flag.StringVar(&separateOpt.Regexp, "regexp", "", "Regexp for separating")
+	cmdSeparate.Flag.StringVar(&separateOpt.Regexp, "regex", "", "Regexp for separating")
+	cmdSeparate.Flag.StringVar(&separateOpt.Regexp, "re", "", "Regexp for separating")
+	cmdSeparate.Flag.BoolVar(&separateOpt.Address, "address", false, "Separate address")
+	cmdSeparate.Flag.BoolVar(&separateOpt.Address, "a", false, "Separate address")
+	cmdSeparate.Flag.BoolVar(&separateOpt.Keep, "keep", false, "Keep target column")
+	cmdSeparate.Flag.BoolVar(&separateOpt.Keep, "k", false, "Keep target column")
+}
+
+// runSeparate executes separate command and return exit code.
+func runSeparate(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, separateOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, separateOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := separateOpt.SeparateOption
+	opt.Into = split(separateOpt.Into)
+	err = csvutil.Separate(r, w, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
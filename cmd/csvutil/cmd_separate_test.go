@@ -0,0 +1,114 @@
+package main
+
+import "testing"
+
+func Example_runSeparate() {
+	separateOpt.Column = "名前"
+	separateOpt.Into = "姓:名"
+	separateOpt.Delimiter = " "
+	runSeparate([]string{testFilePath("address.csv")})
+	separateOpt.Delimiter = ""
+	separateOpt.Into = ""
+	separateOpt.Column = ""
+	// Output: 姓,名,住所
+	// 山田,太郎,東京都千代田区丸の内1-1
+	// 佐藤,花子,大阪府大阪市北区梅田1-1
+}
+
+func Example_runSeparateWithAddress() {
+	separateOpt.Column = "住所"
+	separateOpt.Into = "都道府県:市区町村:町域"
+	separateOpt.Address = true
+	runSeparate([]string{testFilePath("address.csv")})
+	separateOpt.Address = false
+	separateOpt.Into = ""
+	separateOpt.Column = ""
+	// Output: 名前,都道府県,市区町村,町域
+	// 山田 太郎,東京都,千代田区,丸の内1-1
+	// 佐藤 花子,大阪府,大阪市北区,梅田1-1
+}
+
+func Test_runSeparate(t *testing.T) {
+	separateOpt.Column = "名前"
+	separateOpt.Into = "a:b"
+	separateOpt.Regexp = "(.)(.)"
+	if c := runSeparate([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	separateOpt.Regexp = ""
+	separateOpt.Into = ""
+	separateOpt.Column = ""
+}
+
+func Test_runSeparateOnNoFile(t *testing.T) {
+	separateOpt.Column = "0"
+	separateOpt.Into = "a:b"
+	separateOpt.Delimiter = " "
+	if c := runSeparate([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	separateOpt.Delimiter = ""
+	separateOpt.Into = ""
+	separateOpt.Column = ""
+}
+
+func Test_runSeparateOnFail(t *testing.T) {
+	separateOpt.Column = "0"
+	separateOpt.Into = "a:b"
+	separateOpt.Delimiter = " "
+	if c := runSeparate([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	separateOpt.Delimiter = ""
+	separateOpt.Into = ""
+	separateOpt.Column = ""
+}
+
+func Test_runSeparateOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	separateOpt.Column = "名前"
+	separateOpt.Into = "a:b"
+	separateOpt.Regexp = "(.)(.)"
+	separateOpt.Overwrite = true
+	separateOpt.Backup = true
+	runSeparate([]string{tempFilePath()})
+	separateOpt.Backup = false
+	separateOpt.Overwrite = false
+	separateOpt.Regexp = ""
+	separateOpt.Into = ""
+	separateOpt.Column = ""
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runSeparateOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	separateOpt.Column = "名前"
+	separateOpt.Into = "a:b"
+	separateOpt.Regexp = "(.)(.+)"
+	separateOpt.Overwrite = true
+	runSeparate([]string{tempFilePath()})
+	separateOpt.Overwrite = false
+	separateOpt.Regexp = ""
+	separateOpt.Into = ""
+	separateOpt.Column = ""
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c) != 3 || len(c[0]) != 3 || c[0][0] != "a" || c[0][1] != "b" || c[0][2] != "個数" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if c[1][0] != "り" || c[1][1] != "んご" || c[1][2] != "1" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
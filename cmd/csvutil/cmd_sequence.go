@@ -0,0 +1,131 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdSequence = &Command{
+	Run:       runSequence,
+	UsageLine: "sequence [OPTIONS...] [FILE]",
+	Short:     "連番・ID生成",
+	Long: `DESCRIPTION
+        指定した列に連番、もしくは UUID や ULID などの一意なIDを出力します。
+        出力される値は1回の実行の中で一意であることが保証されます。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --column COLUMN_SYMBOL
+            対象となる列のシンボルを指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+
+        -m, --mode MODE
+            出力する値の種類を指定します。
+            対応している値:
+                number: 連番（初期値）
+                uuid4 : UUID バージョン4
+                uuid7 : UUID バージョン7（タイムスタンプを含みます）
+                ulid  : ULID（タイムスタンプを含みます）
+
+        -s, --start NUMBER
+            連番の開始値を指定します。（初期値: 1）
+
+        -st, --step NUMBER
+            連番の増分を指定します。負の値も指定できますが、0 は指定できません。（初期値: 1）
+
+        -f, --format FORMAT
+            連番のフォーマットを C%06d のように Go の fmt パッケージの書式で指定します。（初期値: %d）
+
+        --seed NUMBER
+            乱数のシードを指定します。同じシードを指定した場合、同じ値が出力されます。
+            uuid7, ulid の場合は、タイムスタンプもシードから決定されます。
+            指定されていない場合、実行ごとに異なる値が出力されます。
+	`,
+}
+
+type cmdSequenceOption struct {
+	csvutil.SequenceOption
+	Overwrite bool
+	Backup    bool
+}
+
+var sequenceOpt = cmdSequenceOption{}
+
+func init() {
+	cmdSequence.Flag.BoolVar(&sequenceOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdSequence.Flag.BoolVar(&sequenceOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdSequence.Flag.BoolVar(&sequenceOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdSequence.Flag.BoolVar(&sequenceOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdSequence.Flag.BoolVar(&sequenceOpt.Backup, "backup", false, "Backup source file.")
+	cmdSequence.Flag.BoolVar(&sequenceOpt.Backup, "b", false, "Backup source file.")
+	cmdSequence.Flag.StringVar(&sequenceOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdSequence.Flag.StringVar(&sequenceOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdSequence.Flag.StringVar(&sequenceOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdSequence.Flag.StringVar(&sequenceOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdSequence.Flag.StringVar(&sequenceOpt.Column, "column", "", "Target column symbol")
+	cmdSequence.Flag.StringVar(&sequenceOpt.Column, "c", "", "Target column symbol")
+	cmdSequence.Flag.StringVar(&sequenceOpt.Mode, "mode", "number", "Mode of sequence")
+	cmdSequence.Flag.StringVar(&sequenceOpt.Mode, "m", "number", "Mode of sequence")
+	cmdSequence.Flag.IntVar(&sequenceOpt.Start, "start", 1, "Start number")
+	cmdSequence.Flag.IntVar(&sequenceOpt.Start, "s", 1, "Start number")
+	cmdSequence.Flag.IntVar(&sequenceOpt.Step, "step", 1, "Step of number")
+	cmdSequence.Flag.IntVar(&sequenceOpt.Step, "st", 1, "Step of number")
+	cmdSequence.Flag.StringVar(&sequenceOpt.Format, "format", "%d", "Format of number")
+	cmdSequence.Flag.StringVar(&sequenceOpt.Format, "f", "%d", "Format of number")
+	cmdSequence.Flag.Int64Var(&sequenceOpt.Seed, "seed", 0, "Seed of random")
+}
+
+// runSequence executes sequence command and return exit code.
+func runSequence(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, sequenceOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, sequenceOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	err = csvutil.Sequence(r, w, sequenceOpt.SequenceOption)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
@@ -0,0 +1,54 @@
+package main
+
+import "testing"
+
+func Example_runSequence() {
+	sequenceOpt.Column = "個数"
+	sequenceOpt.Start = 1
+	sequenceOpt.Step = 1
+	sequenceOpt.Format = "C%06d"
+	runSequence([]string{testFilePath("utf8.csv")})
+	sequenceOpt.Format = "%d"
+	sequenceOpt.Column = ""
+	// Output: 名前,個数
+	// りんご,C000001
+	// みかん,C000002
+}
+
+func Test_runSequence(t *testing.T) {
+	sequenceOpt.Column = "個数"
+	sequenceOpt.Mode = "ulid"
+	sequenceOpt.Seed = 1
+	if c := runSequence([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	sequenceOpt.Seed = 0
+	sequenceOpt.Mode = "number"
+	sequenceOpt.Column = ""
+}
+
+func Test_runSequenceOnInvalidMode(t *testing.T) {
+	sequenceOpt.Column = "個数"
+	sequenceOpt.Mode = "foo"
+	if c := runSequence([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	sequenceOpt.Mode = "number"
+	sequenceOpt.Column = ""
+}
+
+func Test_runSequenceOnNoFile(t *testing.T) {
+	sequenceOpt.Column = "個数"
+	if c := runSequence([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	sequenceOpt.Column = ""
+}
+
+func Test_runSequenceOnFail(t *testing.T) {
+	sequenceOpt.Column = "個数"
+	if c := runSequence([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	sequenceOpt.Column = ""
+}
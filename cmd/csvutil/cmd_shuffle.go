@@ -0,0 +1,107 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdShuffle = &Command{
+	Run:       runShuffle,
+	UsageLine: "shuffle [OPTIONS...] [FILE]",
+	Short:     "行シャッフル",
+	Long: `DESCRIPTION
+        ヘッダー行以外の行の順序を無作為に並び替えたCSVを出力します。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        --seed NUMBER
+            乱数のシード値を指定します。同じシード値を指定すると同じ結果が得られます。
+            このオプションを指定しない場合、実行ごとに異なる結果になります。
+
+        -ml, --memory-limit NUMBER
+            メモリ上で並び替えを行うデータサイズの上限をMB単位で指定します。初期値は 64 です。
+            ソースとなるCSVのサイズが上限を超える場合、一時ファイルを使用して並び替えを行います。
+	`,
+}
+
+type cmdShuffleOption struct {
+	csvutil.ShuffleOption
+	Overwrite bool
+	Backup    bool
+	// Memory limit in MB.
+	MemoryLimitMB int
+}
+
+var shuffleOpt = cmdShuffleOption{}
+
+func init() {
+	cmdShuffle.Flag.BoolVar(&shuffleOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdShuffle.Flag.BoolVar(&shuffleOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdShuffle.Flag.BoolVar(&shuffleOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdShuffle.Flag.BoolVar(&shuffleOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdShuffle.Flag.BoolVar(&shuffleOpt.Backup, "backup", false, "Backup source file.")
+	cmdShuffle.Flag.BoolVar(&shuffleOpt.Backup, "b", false, "Backup source file.")
+	cmdShuffle.Flag.StringVar(&shuffleOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdShuffle.Flag.StringVar(&shuffleOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdShuffle.Flag.StringVar(&shuffleOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdShuffle.Flag.StringVar(&shuffleOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdShuffle.Flag.Int64Var(&shuffleOpt.Seed, "seed", 0, "Random seed")
+	cmdShuffle.Flag.IntVar(&shuffleOpt.MemoryLimitMB, "memory-limit", 64, "Memory limit (MB)")
+	cmdShuffle.Flag.IntVar(&shuffleOpt.MemoryLimitMB, "ml", 64, "Memory limit (MB)")
+}
+
+// runShuffle executes shuffle command and return exit code.
+func runShuffle(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, shuffleOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, shuffleOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := shuffleOpt.ShuffleOption
+	opt.MemoryLimit = shuffleOpt.MemoryLimitMB * 1024 * 1024
+	err = csvutil.Shuffle(r, w, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
@@ -0,0 +1,58 @@
+package main
+
+import "testing"
+
+func Test_runShuffle(t *testing.T) {
+	if c := runShuffle([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+}
+
+func Test_runShuffleOnNoFile(t *testing.T) {
+	if c := runShuffle([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runShuffleOnFail(t *testing.T) {
+	if c := runShuffle([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runShuffleOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	shuffleOpt.Overwrite = true
+	shuffleOpt.Backup = true
+	runShuffle([]string{tempFilePath()})
+	shuffleOpt.Backup = false
+	shuffleOpt.Overwrite = false
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runShuffleOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	shuffleOpt.Overwrite = true
+	runShuffle([]string{tempFilePath()})
+	shuffleOpt.Overwrite = false
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c) != 3 || c[0][0] != "名前" || c[0][1] != "個数" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if (c[1][0] != "りんご" || c[2][0] != "みかん") && (c[1][0] != "みかん" || c[2][0] != "りんご") {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdSlice = &Command{
+	Run:       runSlice,
+	UsageLine: "slice [OPTIONS...] [FILE]",
+	Short:     "範囲取得",
+	Long: `DESCRIPTION
+        指定した範囲の行だけを抽出したCSVを出力します。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -f, --from NUMBER
+            抽出する範囲の開始行番号を指定します。ヘッダー行を除いた1始まりの行番号です。初期値は 1 です。
+
+        -t, --to NUMBER
+            抽出する範囲の終了行番号を指定します。指定した行も抽出対象に含まれます。
+            このオプションを指定しない場合、最終行までが対象になります。
+	`,
+}
+
+type cmdSliceOption struct {
+	csvutil.SliceOption
+	Overwrite bool
+	Backup    bool
+}
+
+var sliceOpt = cmdSliceOption{}
+
+func init() {
+	cmdSlice.Flag.BoolVar(&sliceOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdSlice.Flag.BoolVar(&sliceOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdSlice.Flag.BoolVar(&sliceOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdSlice.Flag.BoolVar(&sliceOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdSlice.Flag.BoolVar(&sliceOpt.Backup, "backup", false, "Backup source file.")
+	cmdSlice.Flag.BoolVar(&sliceOpt.Backup, "b", false, "Backup source file.")
+	cmdSlice.Flag.StringVar(&sliceOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdSlice.Flag.StringVar(&sliceOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdSlice.Flag.StringVar(&sliceOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdSlice.Flag.StringVar(&sliceOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdSlice.Flag.IntVar(&sliceOpt.From, "from", 1, "Start line number")
+	cmdSlice.Flag.IntVar(&sliceOpt.From, "f", 1, "Start line number")
+	cmdSlice.Flag.IntVar(&sliceOpt.To, "to", 0, "End line number")
+	cmdSlice.Flag.IntVar(&sliceOpt.To, "t", 0, "End line number")
+}
+
+// runSlice executes slice command and return exit code.
+func runSlice(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, sliceOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, sliceOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	err = csvutil.Slice(r, w, sliceOpt.SliceOption)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
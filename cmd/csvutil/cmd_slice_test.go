@@ -0,0 +1,71 @@
+package main
+
+import "testing"
+
+func Example_runSlice() {
+	sliceOpt.From = 2
+	sliceOpt.To = 2
+	runSlice([]string{testFilePath("utf8.csv")})
+	sliceOpt.To = 0
+	sliceOpt.From = 1
+	// Output: 名前,個数
+	// みかん,2
+}
+
+func Test_runSlice(t *testing.T) {
+	sliceOpt.To = 1
+	if c := runSlice([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	sliceOpt.To = 0
+}
+
+func Test_runSliceOnNoFile(t *testing.T) {
+	if c := runSlice([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runSliceOnFail(t *testing.T) {
+	if c := runSlice([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runSliceOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sliceOpt.To = 1
+	sliceOpt.Overwrite = true
+	sliceOpt.Backup = true
+	runSlice([]string{tempFilePath()})
+	sliceOpt.Backup = false
+	sliceOpt.Overwrite = false
+	sliceOpt.To = 0
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runSliceOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sliceOpt.From = 2
+	sliceOpt.Overwrite = true
+	runSlice([]string{tempFilePath()})
+	sliceOpt.Overwrite = false
+	sliceOpt.From = 1
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c) != 2 || len(c[0]) != 2 || c[0][0] != "名前" || c[0][1] != "個数" || c[1][0] != "みかん" || c[1][1] != "2" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
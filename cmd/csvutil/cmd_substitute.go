@@ -10,6 +10,7 @@ var cmdSubstitute = &Command{
 	Short:     "文字列置換",
 	Long: `DESCRIPTION
         指定した列の値を置換したCSVを出力します。
+        --map-file オプションを指定すると、対応表に従って値を完全一致で置き換えます（辞書モード）。
 
 ARGUMENTS
         FILE
@@ -45,10 +46,14 @@ OPTIONS
                 sjis    : Shift_JISとして出力します
                 eucjp   : EUC_JPとして出力します
 
-        -c, --column COLUMN_SYMBOL
+        -c, --column COLUMN_SYMBOL(S)
             対象となる列のシンボルを指定します。
             列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
             --no-header オプションが指定された場合、インデックスしか受け入れません。
+            複数列を対象としたい場合は、foo:bar や 1:2のようにコロン区切りで指定して下さい。
+
+        -a, --all
+            全ての列を対象とします。--column オプションと同時に指定することはできません。
 
         -p, --pattern PATTERN
             置換対象のパターンです。ここに指定したパターンにマッチする文字列が置き換えられます。
@@ -60,6 +65,26 @@ OPTIONS
         -re, --regex, --regexp
             このオプションが指定されると --pattern に指定された値は正規表現と見なされます。
             初期値は false で、単純な文字列置換を行います。
+            正規表現の場合、--replacement には $1 や ${1} のようにキャプチャグループを参照できます。
+
+        -i, --ignore-case
+            大文字・小文字を区別せずにマッチングします。
+
+        -mf, --map-file FILE
+            置換前の値と置換後の値を1行ずつ記述したCSVファイル（ヘッダーなし）を指定します。
+            値が置換前の値と完全一致した場合、置換後の値に置き換えます。
+            --pattern オプションと同時に指定することはできません。
+
+        -me, --map-encoding ENCODING
+            --map-file オプションで指定したCSVファイルの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとみなします。
+
+        -fb, --fallback FALLBACK
+            --map-file オプション使用時に、対応表に存在しない値の扱いを指定します。
+            対応している値:
+                keep : 値をそのまま出力します（初期値）
+                empty: 空文字を出力します
+                error: エラーとして処理を中断します
 	`,
 }
 
@@ -67,6 +92,12 @@ type cmdSubstituteOption struct {
 	csvutil.SubstituteOption
 	Overwrite bool
 	Backup    bool
+	// Column header or column index separated by colon.
+	Column string
+	// MapFile is path of CSV file that has pairs of old value and new value.
+	MapFile string
+	// MapEncoding is encoding of map file.
+	MapEncoding string
 }
 
 var substituteOpt = cmdSubstituteOption{}
@@ -83,7 +114,7 @@ func init() {
 	cmdSubstitute.Flag.StringVar(&substituteOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
 	cmdSubstitute.Flag.StringVar(&substituteOpt.OutputEncoding, "oe", "", "Encoding for output")
 	cmdSubstitute.Flag.StringVar(&substituteOpt.Column, "column", "", "Target column symbol")
-	cmdSubstitute.Flag.StringVar(&substituteOpt.Column, "c", "", "Home column symbol")
+	cmdSubstitute.Flag.StringVar(&substituteOpt.Column, "c", "", "Target column symbol")
 	cmdSubstitute.Flag.StringVar(&substituteOpt.Pattern, "pattern", "", "Pattern")
 	cmdSubstitute.Flag.StringVar(&substituteOpt.Pattern, "p", "", "Pattern")
 	cmdSubstitute.Flag.StringVar(&substituteOpt.Replacement, "replacement", "", "Replacement")
@@ -91,6 +122,16 @@ func init() {
 	cmdSubstitute.Flag.BoolVar(&substituteOpt.Regexp, "regexp", false, "Pattern is regex")
 	cmdSubstitute.Flag.BoolVar(&substituteOpt.Regexp, "regex", false, "Pattern is regex")
 	cmdSubstitute.Flag.BoolVar(&substituteOpt.Regexp, "re", false, "Pattern is regex")
+	cmdSubstitute.Flag.BoolVar(&substituteOpt.AllColumns, "all", false, "Target all columns")
+	cmdSubstitute.Flag.BoolVar(&substituteOpt.AllColumns, "a", false, "Target all columns")
+	cmdSubstitute.Flag.BoolVar(&substituteOpt.IgnoreCase, "ignore-case", false, "Ignore case")
+	cmdSubstitute.Flag.BoolVar(&substituteOpt.IgnoreCase, "i", false, "Ignore case")
+	cmdSubstitute.Flag.StringVar(&substituteOpt.MapFile, "map-file", "", "Mapping file path")
+	cmdSubstitute.Flag.StringVar(&substituteOpt.MapFile, "mf", "", "Mapping file path")
+	cmdSubstitute.Flag.StringVar(&substituteOpt.MapEncoding, "map-encoding", "", "Encoding of mapping file")
+	cmdSubstitute.Flag.StringVar(&substituteOpt.MapEncoding, "me", "", "Encoding of mapping file")
+	cmdSubstitute.Flag.StringVar(&substituteOpt.Fallback, "fallback", "keep", "Fallback for unmapped value")
+	cmdSubstitute.Flag.StringVar(&substituteOpt.Fallback, "fb", "keep", "Fallback for unmapped value")
 }
 
 // runSubstitute executes substitute command and return exit code.
@@ -107,7 +148,20 @@ func runSubstitute(args []string) int {
 		return handleError(err)
 	}
 
-	err = csvutil.Substitute(r, w, substituteOpt.SubstituteOption)
+	opt := substituteOpt.SubstituteOption
+	opt.ColumnSyms = split(substituteOpt.Column)
+	if substituteOpt.MapFile != "" {
+		enc := substituteOpt.MapEncoding
+		if enc == "" {
+			enc = substituteOpt.Encoding
+		}
+		m, err := readMapping(substituteOpt.MapFile, enc)
+		if err != nil {
+			return handleError(err)
+		}
+		opt.Mapping = m
+	}
+	err = csvutil.Substitute(r, w, opt)
 	if err != nil {
 		return handleError(err)
 	}
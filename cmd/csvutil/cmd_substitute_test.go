@@ -117,3 +117,47 @@ func Test_runSubstituteOnOverwrite(t *testing.T) {
 		t.Fatalf("Overwrite failed. got %+v", c)
 	}
 }
+
+func Example_runSubstituteWithMapFile() {
+	substituteOpt.Column = "0"
+	substituteOpt.MapFile = testFilePath("mapping.csv")
+	runSubstitute([]string{testFilePath("utf8.csv")})
+	substituteOpt.MapFile = ""
+	substituteOpt.Column = ""
+	// Output: 名前,個数
+	// apple,1
+	// みかん,2
+}
+
+func Example_runSubstituteWithMapFileWithBOM() {
+	substituteOpt.Column = "0"
+	substituteOpt.MapFile = testFilePath("mapping_with_bom.csv")
+	runSubstitute([]string{testFilePath("utf8.csv")})
+	substituteOpt.MapFile = ""
+	substituteOpt.Column = ""
+	// Output: 名前,個数
+	// apple,1
+	// みかん,2
+}
+
+func Test_runSubstituteWithMapFileOnUnmapped(t *testing.T) {
+	substituteOpt.Column = "0"
+	substituteOpt.MapFile = testFilePath("mapping.csv")
+	substituteOpt.Fallback = "error"
+	if c := runSubstitute([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	substituteOpt.Fallback = "keep"
+	substituteOpt.MapFile = ""
+	substituteOpt.Column = ""
+}
+
+func Test_runSubstituteWithNoMapFile(t *testing.T) {
+	substituteOpt.Column = "0"
+	substituteOpt.MapFile = testFilePath("no-file.csv")
+	if c := runSubstitute([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	substituteOpt.MapFile = ""
+	substituteOpt.Column = ""
+}
@@ -10,6 +10,7 @@ var cmdTel = &Command{
 	Short:     "電話番号生成",
 	Long: `DESCRIPTION
         指定した列にダミーの電話番号を出力します。
+        固定電話番号は実在する市外局番を使用し、市外局番の桁数に応じて 03-XXXX-XXXX や 0996-XX-XXXX のように区切られます。
 
 ARGUMENTS
         FILE
@@ -53,6 +54,16 @@ OPTIONS
         -mr, --mobile-rate PERCENTAGE
             ダミーの携帯番号を設定する割合を指定します。0〜100までの整数を指定して下さい。（初期値: 0）
             csvutilが埋め込む携帯番号は090,080,070,050で始まるランダムな電話番号です。
+
+        -r, --reference COLUMN_SYMBOL
+            市外局番を決定するために参照する住所の列のシンボルを指定します。
+            列の値に含まれる都道府県と市区町村から市外局番を選択します。都道府県コード（01〜47）も受け入れます。
+            該当する市外局番が見つからない場合、ランダムな市外局番を使用します。
+
+        -f, --fictional
+            番号計画上割り当てられることのない固定電話番号を出力します。
+            市内局番が 0 で始まる番号（例: 03-0123-4567）となり、実在の電話番号と重複しません。
+            --mobile-rate オプションと同時には使用できません。
 	`,
 }
 
@@ -79,6 +90,10 @@ func init() {
 	cmdTel.Flag.StringVar(&telOpt.Column, "c", "", "Home column symbol")
 	cmdTel.Flag.IntVar(&telOpt.MobileRate, "mobile-rate", 0, "Mobile tel number rate")
 	cmdTel.Flag.IntVar(&telOpt.MobileRate, "mr", 0, "Mobile tel number rate")
+	cmdTel.Flag.StringVar(&telOpt.Reference, "reference", "", "Reference address column symbol")
+	cmdTel.Flag.StringVar(&telOpt.Reference, "r", "", "Reference address column symbol")
+	cmdTel.Flag.BoolVar(&telOpt.Fictional, "fictional", false, "Output fictional tel number")
+	cmdTel.Flag.BoolVar(&telOpt.Fictional, "f", false, "Output fictional tel number")
 }
 
 // runTel executes tel command and return exit code.
@@ -13,6 +13,21 @@ func Test_runTel(t *testing.T) {
 	telOpt.Column = ""
 }
 
+func Test_runTelOnFictional(t *testing.T) {
+	telOpt.Column = "名前"
+	telOpt.Fictional = true
+	telOpt.MobileRate = 10
+	if c := runTel([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	telOpt.MobileRate = 0
+	if c := runTel([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	telOpt.Fictional = false
+	telOpt.Column = ""
+}
+
 func Test_runTelOnNoFile(t *testing.T) {
 	if c := runTel([]string{testFilePath("no-file.csv")}); c == 0 {
 		t.Fatalf("Invalid failed exit code: %d", c)
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdTranspose = &Command{
+	Run:       runTranspose,
+	UsageLine: "transpose [OPTIONS...] [FILE]",
+	Short:     "行列入れ替え",
+	Long: `DESCRIPTION
+        行と列を入れ替えたCSVを出力します。
+        ヘッダー行は出力されるCSVの1列目になります。
+        列の数が揃っていない場合は、足りない値を空文字で補完します。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+	`,
+}
+
+type cmdTransposeOption struct {
+	csvutil.TransposeOption
+	Overwrite bool
+	Backup    bool
+}
+
+var transposeOpt = cmdTransposeOption{}
+
+func init() {
+	cmdTranspose.Flag.BoolVar(&transposeOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdTranspose.Flag.BoolVar(&transposeOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdTranspose.Flag.BoolVar(&transposeOpt.Backup, "backup", false, "Backup source file.")
+	cmdTranspose.Flag.BoolVar(&transposeOpt.Backup, "b", false, "Backup source file.")
+	cmdTranspose.Flag.StringVar(&transposeOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdTranspose.Flag.StringVar(&transposeOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdTranspose.Flag.StringVar(&transposeOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdTranspose.Flag.StringVar(&transposeOpt.OutputEncoding, "oe", "", "Encoding for output")
+}
+
+// runTranspose executes transpose command and return exit code.
+func runTranspose(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, transposeOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, transposeOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	err = csvutil.Transpose(r, w, transposeOpt.TransposeOption)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
@@ -0,0 +1,61 @@
+package main
+
+import "testing"
+
+func Example_runTranspose() {
+	runTranspose([]string{testFilePath("utf8.csv")})
+	// Output: 名前,りんご,みかん
+	// 個数,1,2
+}
+
+func Test_runTranspose(t *testing.T) {
+	if c := runTranspose([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+}
+
+func Test_runTransposeOnNoFile(t *testing.T) {
+	if c := runTranspose([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runTransposeOnFail(t *testing.T) {
+	if c := runTranspose([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runTransposeOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	transposeOpt.Overwrite = true
+	transposeOpt.Backup = true
+	runTranspose([]string{tempFilePath()})
+	transposeOpt.Backup = false
+	transposeOpt.Overwrite = false
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runTransposeOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	transposeOpt.Overwrite = true
+	runTranspose([]string{tempFilePath()})
+	transposeOpt.Overwrite = false
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c) != 2 || len(c[0]) != 3 || c[0][0] != "名前" || c[0][1] != "りんご" || c[1][0] != "個数" || c[1][2] != "2" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
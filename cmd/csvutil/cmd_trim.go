@@ -0,0 +1,116 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdTrim = &Command{
+	Run:       runTrim,
+	UsageLine: "trim [OPTIONS...] [FILE]",
+	Short:     "空白除去",
+	Long: `DESCRIPTION
+        指定した列の値の前後の空白（半角スペース、全角スペース、タブ、改行など）を除去したCSVを出力します。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -c, --column COLUMN_SYMBOL(S)
+            対象となる列のシンボルを指定します。指定されていない場合、全ての列が対象になります。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+            複数列を対象としたい場合は、foo:bar や 1:2のようにコロン区切りで指定して下さい。
+
+        -cl, --collapse
+            値の内部で連続する空白を1文字にまとめます。まとめた結果は連続する空白の最初の文字になります。
+
+        -rc, --remove-control
+            制御文字（改行やタブを含みます）を除去します。
+	`,
+}
+
+type cmdTrimOption struct {
+	csvutil.TrimOption
+	// Overwrite to source. (default false)
+	Overwrite bool
+	// Backup source file. (default false)
+	Backup bool
+	// Column header or column index separated by colon.
+	Column string
+}
+
+var trimOpt = cmdTrimOption{}
+
+func init() {
+	cmdTrim.Flag.BoolVar(&trimOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdTrim.Flag.BoolVar(&trimOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdTrim.Flag.BoolVar(&trimOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdTrim.Flag.BoolVar(&trimOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdTrim.Flag.BoolVar(&trimOpt.Backup, "backup", false, "Backup source file.")
+	cmdTrim.Flag.BoolVar(&trimOpt.Backup, "b", false, "Backup source file.")
+	cmdTrim.Flag.StringVar(&trimOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdTrim.Flag.StringVar(&trimOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdTrim.Flag.StringVar(&trimOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdTrim.Flag.StringVar(&trimOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdTrim.Flag.StringVar(&trimOpt.Column, "column", "", "Target column symbol")
+	cmdTrim.Flag.StringVar(&trimOpt.Column, "c", "", "Target column symbol")
+	cmdTrim.Flag.BoolVar(&trimOpt.Collapse, "collapse", false, "Collapse internal whitespaces")
+	cmdTrim.Flag.BoolVar(&trimOpt.Collapse, "cl", false, "Collapse internal whitespaces")
+	cmdTrim.Flag.BoolVar(&trimOpt.RemoveControl, "remove-control", false, "Remove control characters")
+	cmdTrim.Flag.BoolVar(&trimOpt.RemoveControl, "rc", false, "Remove control characters")
+}
+
+// runTrim executes trim command and return exit code.
+func runTrim(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, trimOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, trimOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := trimOpt.TrimOption
+	opt.ColumnSyms = split(trimOpt.Column)
+	err = csvutil.Trim(r, w, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
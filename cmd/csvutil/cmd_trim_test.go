@@ -0,0 +1,41 @@
+package main
+
+import "testing"
+
+func Example_runTrim() {
+	runTrim([]string{testFilePath("spaces.csv")})
+	// Output: 名前,英名
+	// 山田　　太郎,taro  yamada
+	// 佐藤 花子,HANAKO SATO
+}
+
+func Example_runTrimWithCollapse() {
+	trimOpt.Column = "名前"
+	trimOpt.Collapse = true
+	runTrim([]string{testFilePath("spaces.csv")})
+	trimOpt.Collapse = false
+	trimOpt.Column = ""
+	// Output: 名前,英名
+	// 山田　太郎," taro  yamada"
+	// 佐藤 花子,HANAKO SATO
+}
+
+func Test_runTrim(t *testing.T) {
+	trimOpt.RemoveControl = true
+	if c := runTrim([]string{testFilePath("spaces.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	trimOpt.RemoveControl = false
+}
+
+func Test_runTrimOnNoFile(t *testing.T) {
+	if c := runTrim([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
+
+func Test_runTrimOnFail(t *testing.T) {
+	if c := runTrim([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+}
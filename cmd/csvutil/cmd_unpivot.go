@@ -0,0 +1,122 @@
+package main
+
+import (
+	"github.com/pinzolo/csvutil"
+)
+
+var cmdUnpivot = &Command{
+	Run:       runUnpivot,
+	UsageLine: "unpivot [OPTIONS...] [FILE]",
+	Short:     "横縦変換",
+	Long: `DESCRIPTION
+        横持ちのCSVを縦持ちのCSVに変換して出力します。
+        対象となる列の値それぞれが、ヘッダーテキストと値の組として1行ずつ出力されます。
+
+ARGUMENTS
+        FILE
+            ソースとなる CSV ファイルのパスを指定します。
+            パスが指定されていない場合、標準入力が対象となりパイプでの使用ができます。
+
+OPTIONS
+        -w, --overwrite
+            指定されたCSVファイルを実行結果で上書きします。
+            ファイルパスが渡されていない場合には無視されます。
+
+        -H, --no-header
+            ソースとなるCSVの1行目をヘッダー列として扱いません。
+
+        -b, --backup
+            処理が成功した場合に、指定されたCSVファイルをバックアップします。
+            --overwrite オプションと同時に使用されることを想定しているため、ファイルパスが渡されていない場合には無視されます。
+
+        -e, --encoding ENCODING
+            ソースとなるCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合、csvutil はUTF-8とみなして処理を行います。
+            UTF-8であった場合、BOMのあるなしは自動的に判別されます。
+            対応している値:
+                sjis : Shift_JISとして扱います
+                eucjp: EUC_JPとして扱います
+
+        -oe, --output-encoding ENCODING
+            出力するCSVの文字エンコーディングを指定します。
+            このオプションが指定されていない場合 --encoding オプションで指定されたエンコーディングとして出力します。
+            対応している値:
+                utf8    : UTF-8として出力します（BOMは出力しません）
+                utf8bom : UTF-8として出力します（BOMは出力します）
+                sjis    : Shift_JISとして出力します
+                eucjp   : EUC_JPとして出力します
+
+        -k, --keep COLUMN_SYMBOL(S)
+            各行に残す列のシンボルを指定します。
+            列のシンボルとは列のインデックス（0開始）、もしくはヘッダーテキストです。
+            --no-header オプションが指定された場合、インデックスしか受け入れません。
+            複数列を対象としたい場合は、foo:bar や 1:2のようにコロン区切りで指定して下さい。
+
+        -c, --column COLUMN_SYMBOL(S)
+            縦持ちに変換する列のシンボルを指定します。
+            このオプションを指定しない場合、--keep オプションで指定した列以外のすべての列が対象になります。
+
+        -n, --name TEXT
+            変換元のヘッダーテキストを出力する列のヘッダーテキストを指定します。初期値は name です。
+
+        -v, --value TEXT
+            変換元の値を出力する列のヘッダーテキストを指定します。初期値は value です。
+	`,
+}
+
+type cmdUnpivotOption struct {
+	csvutil.UnpivotOption
+	Overwrite bool
+	Backup    bool
+	Keep      string
+	Column    string
+}
+
+var unpivotOpt = cmdUnpivotOption{}
+
+func init() {
+	cmdUnpivot.Flag.BoolVar(&unpivotOpt.Overwrite, "overwrite", false, "Overwrite to source.")
+	cmdUnpivot.Flag.BoolVar(&unpivotOpt.Overwrite, "w", false, "Overwrite to source.")
+	cmdUnpivot.Flag.BoolVar(&unpivotOpt.NoHeader, "no-header", false, "Source file does not have header line.")
+	cmdUnpivot.Flag.BoolVar(&unpivotOpt.NoHeader, "H", false, "Source file does not have header line.")
+	cmdUnpivot.Flag.BoolVar(&unpivotOpt.Backup, "backup", false, "Backup source file.")
+	cmdUnpivot.Flag.BoolVar(&unpivotOpt.Backup, "b", false, "Backup source file.")
+	cmdUnpivot.Flag.StringVar(&unpivotOpt.Encoding, "encoding", "utf8", "Encoding of source file")
+	cmdUnpivot.Flag.StringVar(&unpivotOpt.Encoding, "e", "utf8", "Encoding of source file")
+	cmdUnpivot.Flag.StringVar(&unpivotOpt.OutputEncoding, "output-encoding", "", "Encoding for output")
+	cmdUnpivot.Flag.StringVar(&unpivotOpt.OutputEncoding, "oe", "", "Encoding for output")
+	cmdUnpivot.Flag.StringVar(&unpivotOpt.Keep, "keep", "", "Keep column symbol")
+	cmdUnpivot.Flag.StringVar(&unpivotOpt.Keep, "k", "", "Keep column symbol")
+	cmdUnpivot.Flag.StringVar(&unpivotOpt.Column, "column", "", "Target column symbol")
+	cmdUnpivot.Flag.StringVar(&unpivotOpt.Column, "c", "", "Target column symbol")
+	cmdUnpivot.Flag.StringVar(&unpivotOpt.Name, "name", "name", "Header of name column")
+	cmdUnpivot.Flag.StringVar(&unpivotOpt.Name, "n", "name", "Header of name column")
+	cmdUnpivot.Flag.StringVar(&unpivotOpt.Value, "value", "value", "Header of value column")
+	cmdUnpivot.Flag.StringVar(&unpivotOpt.Value, "v", "value", "Header of value column")
+}
+
+// runUnpivot executes unpivot command and return exit code.
+func runUnpivot(args []string) int {
+	success := false
+	w, wf, r, rf, err := prepare(args, unpivotOpt.Overwrite)
+	if wf != nil {
+		defer wf(&success, unpivotOpt.Backup)
+	}
+	if rf != nil {
+		defer rf()
+	}
+	if err != nil {
+		return handleError(err)
+	}
+
+	opt := unpivotOpt.UnpivotOption
+	opt.KeepSyms = split(unpivotOpt.Keep)
+	opt.ColumnSyms = split(unpivotOpt.Column)
+	err = csvutil.Unpivot(r, w, opt)
+	if err != nil {
+		return handleError(err)
+	}
+
+	success = true
+	return 0
+}
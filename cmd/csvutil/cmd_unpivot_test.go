@@ -0,0 +1,89 @@
+package main
+
+import "testing"
+
+func Example_runUnpivot() {
+	unpivotOpt.Keep = "名前"
+	runUnpivot([]string{testFilePath("utf8.csv")})
+	unpivotOpt.Keep = ""
+	// Output: 名前,name,value
+	// りんご,個数,1
+	// みかん,個数,2
+}
+
+func Test_runUnpivot(t *testing.T) {
+	unpivotOpt.Keep = "名前"
+	if c := runUnpivot([]string{testFilePath("utf8.csv")}); c != 0 {
+		t.Fatalf("Invalid success exit code: %d", c)
+	}
+	unpivotOpt.Keep = ""
+}
+
+func Test_runUnpivotOnUnknownColumn(t *testing.T) {
+	unpivotOpt.Keep = "名前"
+	unpivotOpt.Column = "nope"
+	if c := runUnpivot([]string{testFilePath("utf8.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	unpivotOpt.Column = ""
+	unpivotOpt.Keep = ""
+}
+
+func Test_runUnpivotOnNoFile(t *testing.T) {
+	unpivotOpt.Keep = "0"
+	if c := runUnpivot([]string{testFilePath("no-file.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	unpivotOpt.Keep = ""
+}
+
+func Test_runUnpivotOnFail(t *testing.T) {
+	unpivotOpt.Keep = "0"
+	if c := runUnpivot([]string{testFilePath("broken.csv")}); c == 0 {
+		t.Fatalf("Invalid failed exit code: %d", c)
+	}
+	unpivotOpt.Keep = ""
+}
+
+func Test_runUnpivotOnBackup(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unpivotOpt.Keep = "名前"
+	unpivotOpt.Overwrite = true
+	unpivotOpt.Backup = true
+	runUnpivot([]string{tempFilePath()})
+	unpivotOpt.Backup = false
+	unpivotOpt.Overwrite = false
+	unpivotOpt.Keep = ""
+	if b, err := existsBackup(); err != nil || !b {
+		t.Fatalf("Failed backup")
+	}
+}
+
+func Test_runUnpivotOnOverwrite(t *testing.T) {
+	f, err := prepareWritingTest()
+	defer f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	unpivotOpt.Keep = "名前"
+	unpivotOpt.Name = "項目"
+	unpivotOpt.Overwrite = true
+	runUnpivot([]string{tempFilePath()})
+	unpivotOpt.Overwrite = false
+	unpivotOpt.Name = "name"
+	unpivotOpt.Keep = ""
+	c, err := overwriteContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c) != 3 || len(c[0]) != 3 || c[0][0] != "名前" || c[0][1] != "項目" || c[0][2] != "value" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+	if c[1][0] != "りんご" || c[1][1] != "個数" || c[1][2] != "1" {
+		t.Fatalf("Overwrite failed. got %+v", c)
+	}
+}
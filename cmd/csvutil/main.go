@@ -55,28 +55,49 @@ func (c *Command) Usage() {
 var commands = []*Command{
 	cmdAddress,
 	cmdAppend,
+	cmdBank,
 	cmdBlank,
 	cmdBuilding,
+	cmdCard,
+	cmdCase,
+	cmdChoice,
 	cmdCollect,
 	cmdCombine,
+	cmdCompany,
 	cmdConvert,
 	cmdCount,
+	cmdDate,
 	cmdEmail,
 	cmdExtract,
+	cmdFill,
 	cmdFilter,
 	cmdGenerate,
 	cmdHeader,
 	cmdInsert,
+	cmdLookup,
+	cmdMove,
+	cmdMyNumber,
 	cmdName,
+	cmdNormalize,
 	cmdNumeric,
 	cmdPassword,
+	cmdPivot,
 	cmdRemove,
+	cmdRename,
+	cmdSample,
+	cmdSeparate,
+	cmdSequence,
+	cmdShuffle,
 	cmdSize,
+	cmdSlice,
 	cmdSort,
 	cmdSubstitute,
 	cmdTail,
 	cmdTel,
 	cmdTop,
+	cmdTranspose,
+	cmdTrim,
+	cmdUnpivot,
 	cmdVersion,
 }
 
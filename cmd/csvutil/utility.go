@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -9,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pinzolo/csvutil"
 	"github.com/pkg/errors"
 
 	"golang.org/x/crypto/ssh/terminal"
@@ -93,6 +95,35 @@ func split(s string) []string {
 	return strings.Split(s, ":")
 }
 
+func readLines(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed open")
+	}
+	defer f.Close()
+
+	var lines []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		l := strings.TrimSuffix(sc.Text(), "\r")
+		if l == "" {
+			continue
+		}
+		lines = append(lines, l)
+	}
+	return lines, sc.Err()
+}
+
+func readMapping(path string, enc string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed open")
+	}
+	defer f.Close()
+
+	return csvutil.ReadMapping(f, enc)
+}
+
 func prepare(args []string, ow bool) (io.Writer, func(*bool, bool), io.Reader, func(), error) {
 	path, err := path(args)
 	if err != nil {
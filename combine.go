@@ -1,8 +1,11 @@
 package csvutil
 
 import (
+	"bytes"
 	"io"
+	"strconv"
 	"strings"
+	"text/template"
 
 	"github.com/pkg/errors"
 )
@@ -19,12 +22,21 @@ type CombineOption struct {
 	SourceSyms []string
 	// Destination column symbol
 	Destination string
+	// NewColumn is header of new column for combined value.
+	NewColumn string
+	// Before is column symbol that new column is inserted before. (default last)
+	Before string
 	// Delimiter
 	Delimiter string
+	// Skip empty values on joining.
+	SkipEmpty bool
+	// Template for combined value. (e.g. {{.zip}} {{.pref}}{{.city}})
+	Template string
+	tmpl     *template.Template
 }
 
-func (o CombineOption) validate() error {
-	if len(o.SourceSyms) == 0 {
+func (o *CombineOption) validate() error {
+	if len(o.SourceSyms) == 0 && o.Template == "" {
 		return errors.New("no column")
 	}
 	if o.NoHeader {
@@ -33,6 +45,22 @@ func (o CombineOption) validate() error {
 				return errors.Errorf("not number column symbol: %s", c)
 			}
 		}
+		if !isEmptyOrDigit(o.Before) {
+			return errors.Errorf("not number column symbol: %s", o.Before)
+		}
+	}
+	if o.Destination != "" && o.NewColumn != "" {
+		return errors.New("destination and new column cannot be used together")
+	}
+	if o.Before != "" && o.NewColumn == "" {
+		return errors.New("before requires new column")
+	}
+	if o.Template != "" {
+		t, err := template.New("combine").Option("missingkey=error").Parse(o.Template)
+		if err != nil {
+			return err
+		}
+		o.tmpl = t
 	}
 	return nil
 }
@@ -46,51 +74,93 @@ func (o CombineOption) outputEncoding() string {
 
 // Combine column(s) from CSV.
 func Combine(r io.Reader, w io.Writer, o CombineOption) error {
-	if err := o.validate(); err != nil {
+	opt := &o
+	if err := opt.validate(); err != nil {
 		return errors.Wrap(err, "invalid option")
 	}
 
-	cr, bom := reader(r, o.Encoding)
-	cw := writer(w, bom, o.outputEncoding())
+	cr, bom := reader(r, opt.Encoding)
+	cw := writer(w, bom, opt.outputEncoding())
 	defer cw.Flush()
 
 	var srcs columns
-	var dst *column
+	var dst, before *column
+	var keys []string
+	setup := func(hdr []string) error {
+		srcs = newUniqueColumns(opt.SourceSyms, hdr)
+		dst = newColumnWithIndex(opt.Destination, hdr)
+		before = newColumnWithIndex(opt.Before, hdr)
+		return append(columns{dst, before}, srcs...).err()
+	}
 	csvp := NewCSVProcessor(cr, cw)
-	if o.NoHeader {
+	if opt.NoHeader {
 		csvp.SetPreBodyRead(func() error {
-			srcs = newUniqueColumns(o.SourceSyms, nil)
-			dst = newColumnWithIndex(o.Destination, nil)
-			if err := srcs.err(); err != nil {
-				return err
-			}
-			return dst.err
+			return setup(nil)
 		})
 	} else {
 		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
-			srcs = newUniqueColumns(o.SourceSyms, hdr)
-			dst = newColumnWithIndex(o.Destination, hdr)
-			if err := srcs.err(); err != nil {
+			keys = hdr
+			if err := setup(hdr); err != nil {
 				return hdr, err
 			}
-			return hdr, dst.err
+			if opt.NewColumn != "" {
+				return combineTo(hdr, dst, before, opt.NewColumn), nil
+			}
+			return hdr, nil
 		})
 	}
 	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
-		newRec := make([]string, len(rec))
-		vals := make([]string, len(srcs))
-		for i, src := range srcs {
-			vals[i] = rec[src.index]
+		s, err := opt.combine(rec, srcs, keys)
+		if err != nil {
+			return nil, err
+		}
+		if opt.NewColumn == "" && dst.index == -1 {
+			return rec, nil
 		}
+		return combineTo(rec, dst, before, s), nil
+	})
+
+	return csvp.Process()
+}
+
+func (o *CombineOption) combine(rec []string, srcs columns, keys []string) (string, error) {
+	if o.tmpl != nil {
+		data := make(map[string]string)
 		for i, s := range rec {
-			if i == dst.index {
-				newRec[i] = strings.Join(vals, o.Delimiter)
+			if keys == nil {
+				data["column"+strconv.Itoa(i+1)] = s
 			} else {
-				newRec[i] = s
+				data[keys[i]] = s
 			}
 		}
-		return newRec, nil
-	})
+		buf := &bytes.Buffer{}
+		if err := o.tmpl.Execute(buf, data); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	}
 
-	return csvp.Process()
+	vals := make([]string, 0, len(srcs))
+	for _, src := range srcs {
+		if o.SkipEmpty && rec[src.index] == "" {
+			continue
+		}
+		vals = append(vals, rec[src.index])
+	}
+	return strings.Join(vals, o.Delimiter), nil
+}
+
+func combineTo(rec []string, dst *column, before *column, s string) []string {
+	if dst.index != -1 {
+		newRec := make([]string, len(rec))
+		copy(newRec, rec)
+		newRec[dst.index] = s
+		return newRec
+	}
+	if before.index != -1 {
+		return insertTo(rec, before, 1, []string{s})
+	}
+	newRec := make([]string, len(rec), len(rec)+1)
+	copy(newRec, rec)
+	return append(newRec, s)
 }
@@ -324,3 +324,219 @@ func TestCombineWithSpecialCharDelimiter(t *testing.T) {
 		t.Fatalf("Expectd: %s, but got %s", expected, actual)
 	}
 }
+
+func TestCombineWithDestinationAndNewColumn(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CombineOption{
+		SourceSyms:  []string{"aaa", "bbb"},
+		Destination: "ccc",
+		NewColumn:   "ddd",
+	}
+
+	if err := Combine(r, w, o); err == nil {
+		t.Fatal("Combine with both destination and new column should raise error.")
+	}
+}
+
+func TestCombineWithBeforeButNoNewColumn(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CombineOption{
+		SourceSyms:  []string{"aaa", "bbb"},
+		Destination: "ccc",
+		Before:      "aaa",
+	}
+
+	if err := Combine(r, w, o); err == nil {
+		t.Fatal("Combine with before but no new column should raise error.")
+	}
+}
+
+func TestCombineWithBrokenTemplate(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CombineOption{
+		Template:    "{{.aaa",
+		Destination: "ccc",
+	}
+
+	if err := Combine(r, w, o); err == nil {
+		t.Fatal("Combine with broken template should raise error.")
+	}
+}
+
+func TestCombineWithUnknownTemplateKey(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CombineOption{
+		Template:    "{{.ddd}}",
+		Destination: "ccc",
+	}
+
+	if err := Combine(r, w, o); err == nil {
+		t.Fatal("Combine with unknown header in template should raise error.")
+	}
+}
+
+func TestCombineWithNewColumn(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CombineOption{
+		SourceSyms: []string{"aaa", "ccc"},
+		NewColumn:  "ddd",
+		Delimiter:  "-",
+	}
+
+	if err := Combine(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc,ddd
+1,2,3,1-3
+4,5,6,4-6
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestCombineWithNewColumnAndBefore(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CombineOption{
+		SourceSyms: []string{"aaa", "ccc"},
+		NewColumn:  "ddd",
+		Before:     "bbb",
+	}
+
+	if err := Combine(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,ddd,bbb,ccc
+1,13,2,3
+4,46,5,6
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestCombineWithNewColumnAndNoHeader(t *testing.T) {
+	s := `1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CombineOption{
+		NoHeader:   true,
+		SourceSyms: []string{"0", "2"},
+		NewColumn:  "ddd",
+	}
+
+	if err := Combine(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `1,2,3,13
+4,5,6,46
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestCombineWithSkipEmpty(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CombineOption{
+		SourceSyms:  []string{"aaa", "bbb", "ccc"},
+		Destination: "ccc",
+		Delimiter:   " ",
+		SkipEmpty:   true,
+	}
+
+	if err := Combine(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+1,,1 3
+4,5,4 5 6
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestCombineWithTemplate(t *testing.T) {
+	s := `zip,pref,city
+100-0005,東京都,千代田区
+530-0001,大阪府,大阪市北区
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CombineOption{
+		Template:  "〒{{.zip}} {{.pref}}{{.city}}",
+		NewColumn: "label",
+	}
+
+	if err := Combine(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `zip,pref,city,label
+100-0005,東京都,千代田区,〒100-0005 東京都千代田区
+530-0001,大阪府,大阪市北区,〒530-0001 大阪府大阪市北区
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestCombineWithTemplateAndNoHeader(t *testing.T) {
+	s := `100-0005,東京都,千代田区
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CombineOption{
+		NoHeader:    true,
+		Template:    "{{.column2}}{{.column3}}",
+		Destination: "1",
+	}
+
+	if err := Combine(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `100-0005,東京都千代田区,千代田区
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
@@ -0,0 +1,158 @@
+package csvutil
+
+import (
+	"io"
+	"math/rand"
+
+	"github.com/pkg/errors"
+)
+
+var supportedCompanyTypePositions = []string{"before", "after", "random"}
+
+// CompanyOption is option holder for Company.
+type CompanyOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// Company name column symbol.
+	Column string
+	// Kana of company name column symbol.
+	Kana string
+	// Department column symbol.
+	Department string
+	// Job title column symbol.
+	Title string
+	// Position of company type. (before or after or random, default after)
+	Position string
+	// Output in English.
+	English bool
+}
+
+func (o *CompanyOption) validate() error {
+	if o.Column == "" && o.Kana == "" && o.Department == "" && o.Title == "" {
+		return errors.New("no column")
+	}
+	if o.NoHeader {
+		for _, s := range []string{o.Column, o.Kana, o.Department, o.Title} {
+			if !isEmptyOrDigit(s) {
+				return errors.New("not number column symbol")
+			}
+		}
+	}
+	if o.Position == "" {
+		o.Position = "after"
+	}
+	if o.Column != "" && !o.English && !containsString(supportedCompanyTypePositions, o.Position) {
+		return errors.Errorf("unsupported position: %s", o.Position)
+	}
+	return nil
+}
+
+func (o CompanyOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+type fakeCompany struct {
+	first    companyWord
+	business companyWord
+	typ      companyWord
+	before   bool
+}
+
+func newFakeCompany(pos string) fakeCompany {
+	c := fakeCompany{
+		first:    companyFirstWords[rand.Intn(len(companyFirstWords))],
+		business: companyBusinessWords[rand.Intn(len(companyBusinessWords))],
+		typ:      companyTypes[rand.Intn(len(companyTypes))],
+	}
+	switch pos {
+	case "before":
+		c.before = true
+	case "random":
+		c.before = lot(50)
+	}
+	return c
+}
+
+func (c fakeCompany) name() string {
+	if c.before {
+		return c.typ.kanji + c.first.kanji + c.business.kanji
+	}
+	return c.first.kanji + c.business.kanji + c.typ.kanji
+}
+
+// kana returns kana of company name without company type.
+func (c fakeCompany) kana() string {
+	return c.first.kana + c.business.kana
+}
+
+func (c fakeCompany) englishName() string {
+	return c.first.english + " " + c.business.english + " " + c.typ.english
+}
+
+// Company overwrite values of given columns by dummy company.
+func Company(r io.Reader, w io.Writer, o CompanyOption) error {
+	opt := &o
+	if err := opt.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, opt.Encoding)
+	cw := writer(w, bom, opt.outputEncoding())
+	defer cw.Flush()
+
+	var col, kanaCol, deptCol, titleCol *column
+	setup := func(hdr []string) error {
+		col = newColumnWithIndex(opt.Column, hdr)
+		kanaCol = newColumnWithIndex(opt.Kana, hdr)
+		deptCol = newColumnWithIndex(opt.Department, hdr)
+		titleCol = newColumnWithIndex(opt.Title, hdr)
+		return columns{col, kanaCol, deptCol, titleCol}.err()
+	}
+	csvp := NewCSVProcessor(cr, cw)
+	if opt.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			return setup(nil)
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			return hdr, setup(hdr)
+		})
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		c := newFakeCompany(opt.Position)
+		if col.index != -1 {
+			if opt.English {
+				rec[col.index] = c.englishName()
+			} else {
+				rec[col.index] = c.name()
+			}
+		}
+		if kanaCol.index != -1 {
+			rec[kanaCol.index] = c.kana()
+		}
+		if deptCol.index != -1 {
+			rec[deptCol.index] = opt.word(departments)
+		}
+		if titleCol.index != -1 {
+			rec[titleCol.index] = opt.word(jobTitles)
+		}
+		return rec, nil
+	})
+
+	return csvp.Process()
+}
+
+func (o CompanyOption) word(words []companyWord) string {
+	w := words[rand.Intn(len(words))]
+	if o.English {
+		return w.english
+	}
+	return w.kanji
+}
@@ -0,0 +1,245 @@
+package csvutil
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCompanyWithoutColumn(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CompanyOption{}
+
+	if err := Company(r, w, o); err == nil {
+		t.Fatal("Company without column symbol should raise error.")
+	}
+}
+
+func TestCompanyWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := `1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CompanyOption{
+		NoHeader: true,
+		Column:   "foo",
+		Position: "after",
+	}
+
+	if err := Company(r, w, o); err == nil {
+		t.Fatal("Company with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestCompanyWithUnsupportedPosition(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CompanyOption{
+		Column:   "aaa",
+		Position: "foo",
+	}
+
+	if err := Company(r, w, o); err == nil {
+		t.Fatal("Company with unsupported position should raise error.")
+	}
+}
+
+func TestCompanyWithColumnNotFound(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CompanyOption{
+		Kana: "ddd",
+	}
+
+	if err := Company(r, w, o); err == nil {
+		t.Fatal("Company with not found column should raise error.")
+	}
+}
+
+func hasCompanyType(s string, f func(string, string) bool) bool {
+	for _, ct := range companyTypes {
+		if f(s, ct.kanji) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCompanyWithBefore(t *testing.T) {
+	s := `会社名,会社名カナ
+1,2
+3,4
+5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CompanyOption{
+		Column:   "会社名",
+		Kana:     "会社名カナ",
+		Position: "before",
+	}
+
+	if err := Company(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	for _, rec := range recs[1:] {
+		if !hasCompanyType(rec[0], strings.HasPrefix) {
+			t.Errorf("Company type should be placed before name: %s", rec[0])
+		}
+		if rec[1] == "" || hasCompanyType(rec[1], strings.Contains) {
+			t.Errorf("Invalid kana: %s", rec[1])
+		}
+	}
+}
+
+func TestCompanyWithAfter(t *testing.T) {
+	s := `1,2
+3,4
+5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CompanyOption{
+		NoHeader: true,
+		Column:   "1",
+		Position: "after",
+	}
+
+	if err := Company(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	for _, rec := range recs {
+		if !hasCompanyType(rec[1], strings.HasSuffix) {
+			t.Errorf("Company type should be placed after name: %s", rec[1])
+		}
+	}
+}
+
+func TestCompanyWithoutPosition(t *testing.T) {
+	s := `1,2
+3,4
+5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CompanyOption{
+		NoHeader: true,
+		Column:   "1",
+	}
+
+	if err := Company(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	for _, rec := range recs {
+		if !hasCompanyType(rec[1], strings.HasSuffix) {
+			t.Errorf("Company type should be placed after name by default: %s", rec[1])
+		}
+	}
+}
+
+func TestCompanyWithDepartmentAndTitle(t *testing.T) {
+	s := `部署,役職
+1,2
+3,4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CompanyOption{
+		Department: "部署",
+		Title:      "役職",
+	}
+
+	if err := Company(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	for _, rec := range recs[1:] {
+		if !containsCompanyWord(departments, rec[0], false) {
+			t.Errorf("Invalid department: %s", rec[0])
+		}
+		if !containsCompanyWord(jobTitles, rec[1], false) {
+			t.Errorf("Invalid title: %s", rec[1])
+		}
+	}
+}
+
+func TestCompanyWithEnglish(t *testing.T) {
+	s := `name,department,title
+1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := CompanyOption{
+		Column:     "name",
+		Department: "department",
+		Title:      "title",
+		English:    true,
+	}
+
+	if err := Company(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	for _, rec := range recs[1:] {
+		found := false
+		for _, ct := range companyTypes {
+			if strings.HasSuffix(rec[0], " "+ct.english) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Invalid English company name: %s", rec[0])
+		}
+		if !containsCompanyWord(departments, rec[1], true) {
+			t.Errorf("Invalid department: %s", rec[1])
+		}
+		if !containsCompanyWord(jobTitles, rec[2], true) {
+			t.Errorf("Invalid title: %s", rec[2])
+		}
+	}
+}
+
+func containsCompanyWord(words []companyWord, s string, english bool) bool {
+	for _, w := range words {
+		if (english && w.english == s) || (!english && w.kanji == s) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestFakeCompany(t *testing.T) {
+	c := fakeCompany{
+		first:    companyWord{"山田", "ヤマダ", "Yamada"},
+		business: companyWord{"商事", "ショウジ", "Trading"},
+		typ:      companyWord{"株式会社", "カブシキガイシャ", "Co., Ltd."},
+	}
+	if n := c.name(); n != "山田商事株式会社" {
+		t.Errorf("Expected 山田商事株式会社, but got %s", n)
+	}
+	if k := c.kana(); k != "ヤマダショウジ" {
+		t.Errorf("Expected ヤマダショウジ, but got %s", k)
+	}
+	if e := c.englishName(); e != "Yamada Trading Co., Ltd." {
+		t.Errorf("Expected Yamada Trading Co., Ltd., but got %s", e)
+	}
+	c.before = true
+	if n := c.name(); n != "株式会社山田商事" {
+		t.Errorf("Expected 株式会社山田商事, but got %s", n)
+	}
+}
@@ -0,0 +1,252 @@
+package csvutil
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// dateLayouts are layouts for parsing from and to value.
+var dateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02 15:04:05",
+	"2006/01/02 15:04",
+	"2006/01/02",
+	"15:04:05",
+	"15:04",
+}
+
+type japaneseEra struct {
+	name  string
+	start time.Time
+}
+
+// japaneseEras is sorted by start date descending.
+var japaneseEras = []japaneseEra{
+	{"令和", time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)},
+	{"平成", time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC)},
+	{"昭和", time.Date(1926, 12, 25, 0, 0, 0, 0, time.UTC)},
+	{"大正", time.Date(1912, 7, 30, 0, 0, 0, 0, time.UTC)},
+	{"明治", time.Date(1868, 10, 23, 0, 0, 0, 0, time.UTC)},
+}
+
+var japaneseEraRegex = regexp.MustCompile(`^(明治|大正|昭和|平成|令和)(元|\d+)年(\d+)月(\d+)日$`)
+
+// DateOption is option holder for Date.
+type DateOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// Target column symbol.
+	Column string
+	// From is minimum date. (inclusive)
+	From string
+	// To is maximum date. (inclusive)
+	To string
+	// Format is layout of Go time package. (default 2006-01-02)
+	Format string
+	// Output date in Japanese era. (e.g. 令和5年4月1日)
+	Era bool
+	// Timezone name. (e.g. Asia/Tokyo, default Local)
+	Timezone string
+	// After is column symbol, generated date is after the value of this column.
+	After string
+	loc   *time.Location
+	from  time.Time
+	to    time.Time
+}
+
+func (o *DateOption) validate() error {
+	if o.Column == "" {
+		return errors.New("no column")
+	}
+	if o.NoHeader {
+		if !isDigit(o.Column) || !isEmptyOrDigit(o.After) {
+			return errors.New("not number column symbol")
+		}
+	}
+	if o.Format == "" {
+		o.Format = "2006-01-02"
+	}
+	loc, err := loadLocation(o.Timezone)
+	if err != nil {
+		return err
+	}
+	o.loc = loc
+	if o.from, err = o.parseBoundary(o.From); err != nil {
+		return errors.Wrap(err, "invalid from")
+	}
+	if o.to, err = o.parseBoundary(o.To); err != nil {
+		return errors.Wrap(err, "invalid to")
+	}
+	if o.to.Before(o.from) {
+		return errors.New("to should not be before from")
+	}
+	return nil
+}
+
+func (o DateOption) parseBoundary(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, errors.New("empty value")
+	}
+	return o.parse(s, append([]string{o.Format}, dateLayouts...))
+}
+
+func (o DateOption) parse(s string, layouts []string) (time.Time, error) {
+	if m := japaneseEraRegex.FindStringSubmatch(s); m != nil {
+		return parseJapaneseEra(m, o.loc)
+	}
+	for _, l := range layouts {
+		if t, err := time.ParseInLocation(l, s, o.loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("cannot parse date: %s", s)
+}
+
+func (o DateOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// unit returns minimum unit of date from format.
+func (o DateOption) unit() time.Duration {
+	if o.Era {
+		return 24 * time.Hour
+	}
+	switch {
+	case strings.Contains(o.Format, "05"):
+		return time.Second
+	case strings.Contains(o.Format, "04"):
+		return time.Minute
+	case strings.Contains(o.Format, "15"), strings.Contains(o.Format, "03"):
+		return time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// Date overwrite value of given column by random dates.
+func Date(r io.Reader, w io.Writer, o DateOption) error {
+	opt := &o
+	if err := opt.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, opt.Encoding)
+	cw := writer(w, bom, opt.outputEncoding())
+	defer cw.Flush()
+
+	var col, after *column
+	setup := func(hdr []string) error {
+		col = newColumnWithIndex(opt.Column, hdr)
+		after = newColumnWithIndex(opt.After, hdr)
+		return columns{col, after}.err()
+	}
+	csvp := NewCSVProcessor(cr, cw)
+	if opt.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			return setup(nil)
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			return hdr, setup(hdr)
+		})
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		from := opt.from
+		if after.index != -1 && rec[after.index] != "" {
+			t, err := opt.parse(rec[after.index], []string{opt.Format})
+			if err != nil {
+				return nil, err
+			}
+			if t = t.Add(opt.unit()); t.After(from) {
+				from = t
+			}
+		}
+		if from.After(opt.to) {
+			return nil, errors.Errorf("cannot generate date after %s", rec[after.index])
+		}
+		rec[col.index] = opt.format(fakeDate(from, opt.to, opt.unit()))
+		return rec, nil
+	})
+
+	return csvp.Process()
+}
+
+func (o DateOption) format(t time.Time) string {
+	if o.Era {
+		return formatJapaneseEra(t)
+	}
+	return t.Format(o.Format)
+}
+
+// fakeDate returns random date between from and to.
+func fakeDate(from time.Time, to time.Time, unit time.Duration) time.Time {
+	if unit == 24*time.Hour {
+		days := int(calendarDate(to).Sub(calendarDate(from)).Hours()/24) + 1
+		return from.AddDate(0, 0, rand.Intn(days))
+	}
+	n := int64(to.Sub(from)/unit) + 1
+	return from.Add(time.Duration(rand.Int63n(n)) * unit)
+}
+
+// calendarDate returns date of given time in UTC to count days regardless of daylight saving time.
+func calendarDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func loadLocation(tz string) (*time.Location, error) {
+	if tz == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid timezone: %s", tz)
+	}
+	return loc, nil
+}
+
+func formatJapaneseEra(t time.Time) string {
+	for _, e := range japaneseEras {
+		s := time.Date(e.start.Year(), e.start.Month(), e.start.Day(), 0, 0, 0, 0, t.Location())
+		if t.Before(s) {
+			continue
+		}
+		y := t.Year() - e.start.Year() + 1
+		ys := strconv.Itoa(y)
+		if y == 1 {
+			ys = "元"
+		}
+		return fmt.Sprintf("%s%s年%d月%d日", e.name, ys, t.Month(), t.Day())
+	}
+	return t.Format("2006年1月2日")
+}
+
+func parseJapaneseEra(m []string, loc *time.Location) (time.Time, error) {
+	y := 1
+	if m[2] != "元" {
+		y, _ = strconv.Atoi(m[2])
+	}
+	mon, _ := strconv.Atoi(m[3])
+	d, _ := strconv.Atoi(m[4])
+	for _, e := range japaneseEras {
+		if e.name == m[1] {
+			return time.Date(e.start.Year()+y-1, time.Month(mon), d, 0, 0, 0, 0, loc), nil
+		}
+	}
+	return time.Time{}, errors.Errorf("unknown era: %s", m[1])
+}
@@ -0,0 +1,310 @@
+package csvutil
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestDateWithoutColumn(t *testing.T) {
+	s := `aaa,bbb
+1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := DateOption{
+		From: "2000-01-01",
+		To:   "2000-12-31",
+	}
+
+	if err := Date(r, w, o); err == nil {
+		t.Fatal("Date without column should raise error.")
+	}
+}
+
+func TestDateWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := `1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := DateOption{
+		NoHeader: true,
+		Column:   "aaa",
+		From:     "2000-01-01",
+		To:       "2000-12-31",
+	}
+
+	if err := Date(r, w, o); err == nil {
+		t.Fatal("Date with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestDateWithInvalidRange(t *testing.T) {
+	s := `aaa,bbb
+1,2
+`
+	tests := []struct {
+		from string
+		to   string
+	}{
+		{"", "2000-12-31"},
+		{"2000-01-01", ""},
+		{"2000-13-01", "2000-12-31"},
+		{"2001-01-01", "2000-12-31"},
+	}
+	for _, tt := range tests {
+		r := bytes.NewBufferString(s)
+		w := &bytes.Buffer{}
+		o := DateOption{
+			Column: "aaa",
+			From:   tt.from,
+			To:     tt.to,
+		}
+
+		if err := Date(r, w, o); err == nil {
+			t.Errorf("Date with from %q and to %q should raise error.", tt.from, tt.to)
+		}
+	}
+}
+
+func TestDateWithInvalidTimezone(t *testing.T) {
+	s := `aaa,bbb
+1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := DateOption{
+		Column:   "aaa",
+		From:     "2000-01-01",
+		To:       "2000-12-31",
+		Timezone: "Foo/Bar",
+	}
+
+	if err := Date(r, w, o); err == nil {
+		t.Fatal("Date with invalid timezone should raise error.")
+	}
+}
+
+func TestDateWithUnknownAfter(t *testing.T) {
+	s := `aaa,bbb
+1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := DateOption{
+		Column: "aaa",
+		From:   "2000-01-01",
+		To:     "2000-12-31",
+		After:  "ccc",
+	}
+
+	if err := Date(r, w, o); err == nil {
+		t.Fatal("Date with unknown after column should raise error.")
+	}
+}
+
+func TestDate(t *testing.T) {
+	s := `aaa,bbb
+1,2
+3,4
+5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := DateOption{
+		Column: "bbb",
+		From:   "1950-01-01",
+		To:     "2005-12-31",
+		Format: "2006/01/02",
+	}
+
+	if err := Date(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Date(1950, 1, 1, 0, 0, 0, 0, time.Local)
+	to := time.Date(2005, 12, 31, 0, 0, 0, 0, time.Local)
+	for _, rec := range readCSV(w.String())[1:] {
+		d, err := time.ParseInLocation("2006/01/02", rec[1], time.Local)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.Before(from) || d.After(to) {
+			t.Errorf("%s is out of range", rec[1])
+		}
+	}
+}
+
+func TestDateWithTime(t *testing.T) {
+	s := `aaa,bbb
+1,2
+3,4
+5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := DateOption{
+		Column: "bbb",
+		From:   "09:00",
+		To:     "09:05",
+		Format: "15:04",
+	}
+
+	if err := Date(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rec := range readCSV(w.String())[1:] {
+		if rec[1] < "09:00" || "09:05" < rec[1] {
+			t.Errorf("%s is out of range", rec[1])
+		}
+	}
+}
+
+func TestDateWithTimezone(t *testing.T) {
+	s := `aaa,bbb
+1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := DateOption{
+		Column:   "bbb",
+		From:     "2018-04-01 12:00:00",
+		To:       "2018-04-01 12:00:00",
+		Format:   time.RFC3339,
+		Timezone: "Asia/Tokyo",
+	}
+
+	if err := Date(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb
+1,2018-04-01T12:00:00+09:00
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestDateWithDaylightSavingTime(t *testing.T) {
+	r := bytes.NewBufferString(generateCSV("aaa,bbb", 1000, func(int) string { return "1,2" }))
+	w := &bytes.Buffer{}
+	o := DateOption{
+		Column:   "bbb",
+		From:     "2021-03-01",
+		To:       "2021-03-20",
+		Format:   "2006-01-02",
+		Timezone: "America/New_York",
+	}
+
+	if err := Date(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, rec := range readCSV(w.String())[1:] {
+		if rec[1] < "2021-03-01" || "2021-03-20" < rec[1] {
+			t.Errorf("%s is out of range", rec[1])
+		}
+		if rec[1] == "2021-03-20" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Last day of range should be generated across daylight saving time.")
+	}
+}
+
+func TestDateWithEra(t *testing.T) {
+	s := `aaa,bbb
+1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := DateOption{
+		Column: "bbb",
+		From:   "令和5年4月1日",
+		To:     "2023-04-01",
+		Era:    true,
+	}
+
+	if err := Date(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb
+1,令和5年4月1日
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestDateWithAfter(t *testing.T) {
+	s := `created_at,updated_at
+2000-12-29,
+2000-12-30,
+,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := DateOption{
+		Column: "updated_at",
+		From:   "2000-01-01",
+		To:     "2000-12-31",
+		After:  "created_at",
+	}
+
+	if err := Date(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	data := readCSV(w.String())
+	if v := data[1][1]; v != "2000-12-30" && v != "2000-12-31" {
+		t.Errorf("%s is not after %s", v, data[1][0])
+	}
+	if v := data[2][1]; v != "2000-12-31" {
+		t.Errorf("%s is not after %s", v, data[2][0])
+	}
+	if v := data[3][1]; v == "" {
+		t.Error("date should be generated when after column is empty")
+	}
+}
+
+func TestDateWithAfterOutOfRange(t *testing.T) {
+	s := `created_at,updated_at
+2000-12-31,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := DateOption{
+		Column: "updated_at",
+		From:   "2000-01-01",
+		To:     "2000-12-31",
+		After:  "created_at",
+	}
+
+	if err := Date(r, w, o); err == nil {
+		t.Fatal("Date that cannot be after other column should raise error.")
+	}
+}
+
+func TestFormatJapaneseEra(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		expected string
+	}{
+		{time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), "令和5年4月1日"},
+		{time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), "令和元年5月1日"},
+		{time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC), "平成31年4月30日"},
+		{time.Date(1989, 1, 7, 0, 0, 0, 0, time.UTC), "昭和64年1月7日"},
+		{time.Date(1926, 12, 25, 0, 0, 0, 0, time.UTC), "昭和元年12月25日"},
+		{time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), "明治33年1月1日"},
+	}
+	for _, tt := range tests {
+		if actual := formatJapaneseEra(tt.date); actual != tt.expected {
+			t.Errorf("Expected: %s, but got %s", tt.expected, actual)
+		}
+	}
+}
@@ -1,11 +1,14 @@
 package csvutil
 
 import (
+	"fmt"
 	"io"
+	"math/rand"
 	"strings"
 
 	"github.com/icrowley/fake"
 	"github.com/pkg/errors"
+	"golang.org/x/text/unicode/norm"
 )
 
 var mobileEmailDomains = []string{
@@ -17,6 +20,29 @@ var mobileEmailDomains = []string{
 	"emobile.ne.jp",
 }
 
+// safeEmailDomains are domains reserved for example or invalid use.
+var safeEmailDomains = []string{
+	"example.com",
+	"example.net",
+	"example.org",
+	"example.jp",
+	"example.co.jp",
+	"example.ne.jp",
+	"example.invalid",
+}
+
+// emailLocalPartFormats are formats of local part built from name.
+// Arguments are first name, last name, initial of first name and random number.
+var emailLocalPartFormats = []string{
+	"%[1]s.%[2]s",
+	"%[1]s_%[2]s",
+	"%[2]s.%[1]s",
+	"%[3]s-%[2]s",
+	"%[3]s.%[2]s",
+	"%[2]s%[4]d",
+	"%[1]s%[4]d",
+}
+
 // EmailOption is option holder for Email.
 type EmailOption struct {
 	// Source file does not have header line. (default false)
@@ -29,6 +55,12 @@ type EmailOption struct {
 	Column string
 	// Rate of output mobile email address.
 	MobileRate int
+	// Reference romanized name column symbol for local part.
+	Reference string
+	// Reference name is written in order of last name and first name.
+	LastNameFirst bool
+	// Use only domains reserved for example or invalid use.
+	SafeDomain bool
 }
 
 func (o EmailOption) validate() error {
@@ -40,10 +72,16 @@ func (o EmailOption) validate() error {
 			return errors.New("not number column symbol")
 
 		}
+		if !isEmptyOrDigit(o.Reference) {
+			return errors.New("not number reference column symbol")
+		}
 	}
 	if o.MobileRate < 0 || 100 < o.MobileRate {
 		return errors.New("invalid mobile rate (0 <= rate <= 100)")
 	}
+	if o.SafeDomain && o.MobileRate > 0 {
+		return errors.New("mobile email address is not available with safe domain")
+	}
 	return nil
 }
 
@@ -64,25 +102,39 @@ func Email(r io.Reader, w io.Writer, o EmailOption) error {
 	cw := writer(w, bom, o.outputEncoding())
 	defer cw.Flush()
 
-	var col *column
+	var col, ref *column
+	setup := func(hdr []string) error {
+		col = newColumnWithIndex(o.Column, hdr)
+		ref = newColumnWithIndex(o.Reference, hdr)
+		return columns{col, ref}.err()
+	}
 	csvp := NewCSVProcessor(cr, cw)
 	if o.NoHeader {
 		csvp.SetPreBodyRead(func() error {
-			col = newColumnWithIndex(o.Column, nil)
-			return col.err
+			return setup(nil)
 		})
 	} else {
 		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
-			col = newColumnWithIndex(o.Column, hdr)
-			return hdr, col.err
+			return hdr, setup(hdr)
 		})
 	}
 	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
-		if lot(o.MobileRate) {
-			rec[col.index] = fakeMobileEmail()
-		} else {
-			rec[col.index] = fakeEmail()
+		var local string
+		if ref.index != -1 {
+			local = emailLocalPart(rec[ref.index], o.LastNameFirst)
+		}
+		if local == "" && !o.SafeDomain {
+			if lot(o.MobileRate) {
+				rec[col.index] = fakeMobileEmail()
+			} else {
+				rec[col.index] = fakeEmail()
+			}
+			return rec, nil
+		}
+		if local == "" {
+			local = strings.ToLower(fake.UserName())
 		}
+		rec[col.index] = local + "@" + o.domain()
 		return rec, nil
 	})
 
@@ -96,3 +148,48 @@ func fakeEmail() string {
 func fakeMobileEmail() string {
 	return strings.ToLower(fake.UserName()) + "@" + sampleString(mobileEmailDomains)
 }
+
+func (o EmailOption) domain() string {
+	if o.SafeDomain {
+		return sampleString(safeEmailDomains)
+	}
+	if lot(o.MobileRate) {
+		return sampleString(mobileEmailDomains)
+	}
+	return strings.ToLower(fake.DomainName())
+}
+
+// emailLocalPart returns local part of email address built from romanized name.
+// If name does not contain any alphabet, emailLocalPart returns empty string.
+func emailLocalPart(name string, lastNameFirst bool) string {
+	var ss []string
+	for _, s := range strings.Fields(name) {
+		if a := toASCIILetters(s); a != "" {
+			ss = append(ss, a)
+		}
+	}
+	if len(ss) == 0 {
+		return ""
+	}
+	if len(ss) == 1 {
+		return fmt.Sprintf("%s%d", ss[0], rand.Intn(999)+1)
+	}
+	first, last := ss[0], ss[len(ss)-1]
+	if lastNameFirst {
+		first, last = last, first
+	}
+	f := sampleString(emailLocalPartFormats)
+	return fmt.Sprintf(f, first, last, first[:1], rand.Intn(999)+1)
+}
+
+// toASCIILetters returns lower case ASCII letters in s.
+// Letters with macron (e.g. ō) are converted to base letters.
+func toASCIILetters(s string) string {
+	b := make([]byte, 0, len(s))
+	for _, r := range norm.NFD.String(strings.ToLower(s)) {
+		if 'a' <= r && r <= 'z' {
+			b = append(b, byte(r))
+		}
+	}
+	return string(b)
+}
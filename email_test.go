@@ -3,6 +3,7 @@ package csvutil
 import (
 	"bytes"
 	"io/ioutil"
+	"regexp"
 	"strings"
 	"testing"
 )
@@ -193,6 +194,145 @@ func TestEmailWithNoHeader(t *testing.T) {
 	}
 }
 
+func TestEmailWithNoHeaderButReferenceNotNumber(t *testing.T) {
+	s := `1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := EmailOption{
+		NoHeader:  true,
+		Column:    "0",
+		Reference: "foo",
+	}
+
+	if err := Email(r, w, o); err == nil {
+		t.Fatal("Email with not number reference column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestEmailWithSafeDomainAndMobileRate(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := EmailOption{
+		Column:     "aaa",
+		MobileRate: 10,
+		SafeDomain: true,
+	}
+
+	if err := Email(r, w, o); err == nil {
+		t.Fatal("Email with safe domain and mobile rate should raise error.")
+	}
+}
+
+func TestEmailWithReference(t *testing.T) {
+	s := `name,email
+Taro Yamada,
+Hanako Satō,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := EmailOption{
+		Column:    "email",
+		Reference: "name",
+	}
+
+	if err := Email(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	data := readCSV(w.String())
+	rgxs := []*regexp.Regexp{
+		regexp.MustCompile(`^(taro[._]yamada|yamada\.taro|t[-.]yamada|yamada\d+|taro\d+)@[a-z0-9.-]+$`),
+		regexp.MustCompile(`^(hanako[._]sato|sato\.hanako|h[-.]sato|sato\d+|hanako\d+)@[a-z0-9.-]+$`),
+	}
+	for i, rgx := range rgxs {
+		if !rgx.MatchString(data[i+1][1]) {
+			t.Errorf("Email should be built from %s, but got %s", data[i+1][0], data[i+1][1])
+		}
+	}
+}
+
+func TestEmailWithReferenceLastNameFirst(t *testing.T) {
+	s := `YAMADA Taro,
+SUZUKI,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := EmailOption{
+		NoHeader:      true,
+		Column:        "1",
+		Reference:     "0",
+		LastNameFirst: true,
+		SafeDomain:    true,
+	}
+
+	if err := Email(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	data := readCSV(w.String())
+	rgxs := []*regexp.Regexp{
+		regexp.MustCompile(`^(taro[._]yamada|yamada\.taro|t[-.]yamada|yamada\d+|taro\d+)@`),
+		regexp.MustCompile(`^suzuki\d+@`),
+	}
+	for i, rgx := range rgxs {
+		if !rgx.MatchString(data[i][1]) {
+			t.Errorf("Email should be built from %s, but got %s", data[i][0], data[i][1])
+		}
+		if !isSafeEmail(data[i][1]) {
+			t.Errorf("Email should have safe domain: %s", data[i][1])
+		}
+	}
+}
+
+func TestEmailWithSafeDomain(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+7,8,9
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := EmailOption{
+		Column:     "aaa",
+		SafeDomain: true,
+	}
+
+	if err := Email(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	data := readCSV(w.String())
+	if ok := allOK(data, 0, isSafeEmail); !ok {
+		t.Fatalf("Email failed updating on safe email address. %+v", data)
+	}
+}
+
+func TestEmailLocalPart(t *testing.T) {
+	if l := emailLocalPart("", false); l != "" {
+		t.Errorf("Local part should be empty for empty name, but got %s", l)
+	}
+	if l := emailLocalPart("山田 太郎", false); l != "" {
+		t.Errorf("Local part should be empty for not romanized name, but got %s", l)
+	}
+	if l := emailLocalPart("Ōno Jun'ichi", true); !regexp.MustCompile(`^(junichi[._]ono|ono\.junichi|j[-.]ono|ono\d+|junichi\d+)$`).MatchString(l) {
+		t.Errorf("Invalid local part: %s", l)
+	}
+}
+
+func isSafeEmail(s string) bool {
+	for _, d := range safeEmailDomains {
+		if strings.HasSuffix(s, "@"+d) {
+			return true
+		}
+	}
+	return false
+}
+
 func isEmail(s string) bool {
 	return strings.Contains(s, "@")
 }
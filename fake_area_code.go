@@ -0,0 +1,149 @@
+package csvutil
+
+type areaCode struct {
+	prefecture string
+	city       string
+	code       string
+}
+
+// areaCodes is table of area codes for landline tel number.
+// Each prefecture has at least one area code.
+var areaCodes = []areaCode{
+	{"北海道", "札幌市", "011"},
+	{"北海道", "小樽市", "0134"},
+	{"北海道", "函館市", "0138"},
+	{"北海道", "釧路市", "0154"},
+	{"北海道", "帯広市", "0155"},
+	{"北海道", "旭川市", "0166"},
+	{"青森県", "青森市", "017"},
+	{"青森県", "弘前市", "0172"},
+	{"青森県", "八戸市", "0178"},
+	{"岩手県", "盛岡市", "019"},
+	{"宮城県", "仙台市", "022"},
+	{"秋田県", "秋田市", "018"},
+	{"山形県", "山形市", "023"},
+	{"福島県", "福島市", "024"},
+	{"福島県", "郡山市", "024"},
+	{"福島県", "いわき市", "0246"},
+	{"茨城県", "水戸市", "029"},
+	{"茨城県", "つくば市", "029"},
+	{"栃木県", "宇都宮市", "028"},
+	{"群馬県", "前橋市", "027"},
+	{"群馬県", "高崎市", "027"},
+	{"埼玉県", "さいたま市", "048"},
+	{"埼玉県", "川越市", "049"},
+	{"埼玉県", "所沢市", "04"},
+	{"千葉県", "千葉市", "043"},
+	{"千葉県", "船橋市", "047"},
+	{"千葉県", "柏市", "04"},
+	{"千葉県", "成田市", "0476"},
+	{"東京都", "千代田区", "03"},
+	{"東京都", "中央区", "03"},
+	{"東京都", "港区", "03"},
+	{"東京都", "新宿区", "03"},
+	{"東京都", "文京区", "03"},
+	{"東京都", "台東区", "03"},
+	{"東京都", "墨田区", "03"},
+	{"東京都", "江東区", "03"},
+	{"東京都", "品川区", "03"},
+	{"東京都", "目黒区", "03"},
+	{"東京都", "大田区", "03"},
+	{"東京都", "世田谷区", "03"},
+	{"東京都", "渋谷区", "03"},
+	{"東京都", "中野区", "03"},
+	{"東京都", "杉並区", "03"},
+	{"東京都", "豊島区", "03"},
+	{"東京都", "北区", "03"},
+	{"東京都", "荒川区", "03"},
+	{"東京都", "板橋区", "03"},
+	{"東京都", "練馬区", "03"},
+	{"東京都", "足立区", "03"},
+	{"東京都", "葛飾区", "03"},
+	{"東京都", "江戸川区", "03"},
+	{"東京都", "八王子市", "042"},
+	{"東京都", "立川市", "042"},
+	{"東京都", "武蔵野市", "0422"},
+	{"東京都", "三鷹市", "0422"},
+	{"東京都", "青梅市", "0428"},
+	{"東京都", "府中市", "042"},
+	{"東京都", "昭島市", "042"},
+	{"東京都", "調布市", "042"},
+	{"東京都", "町田市", "042"},
+	{"東京都", "小金井市", "042"},
+	{"東京都", "小平市", "042"},
+	{"東京都", "日野市", "042"},
+	{"東京都", "東村山市", "042"},
+	{"東京都", "国分寺市", "042"},
+	{"東京都", "国立市", "042"},
+	{"東京都", "福生市", "042"},
+	{"東京都", "狛江市", "03"},
+	{"東京都", "東大和市", "042"},
+	{"東京都", "清瀬市", "042"},
+	{"東京都", "東久留米市", "042"},
+	{"東京都", "武蔵村山市", "042"},
+	{"東京都", "多摩市", "042"},
+	{"東京都", "稲城市", "042"},
+	{"東京都", "羽村市", "042"},
+	{"東京都", "あきる野市", "042"},
+	{"東京都", "西東京市", "042"},
+	{"東京都", "瑞穂町", "042"},
+	{"東京都", "日の出町", "042"},
+	{"東京都", "檜原村", "042"},
+	{"東京都", "奥多摩町", "0428"},
+	{"東京都", "大島町", "04992"},
+	{"東京都", "三宅村", "04994"},
+	{"東京都", "八丈町", "04996"},
+	{"東京都", "小笠原村", "04998"},
+	{"神奈川県", "横浜市", "045"},
+	{"神奈川県", "川崎市", "044"},
+	{"神奈川県", "相模原市", "042"},
+	{"神奈川県", "小田原市", "0465"},
+	{"神奈川県", "藤沢市", "0466"},
+	{"新潟県", "新潟市", "025"},
+	{"新潟県", "長岡市", "0258"},
+	{"富山県", "富山市", "076"},
+	{"石川県", "金沢市", "076"},
+	{"福井県", "福井市", "0776"},
+	{"山梨県", "甲府市", "055"},
+	{"長野県", "長野市", "026"},
+	{"長野県", "松本市", "0263"},
+	{"岐阜県", "岐阜市", "058"},
+	{"静岡県", "静岡市", "054"},
+	{"静岡県", "浜松市", "053"},
+	{"静岡県", "沼津市", "055"},
+	{"愛知県", "名古屋市", "052"},
+	{"愛知県", "豊橋市", "0532"},
+	{"愛知県", "豊田市", "0565"},
+	{"三重県", "津市", "059"},
+	{"三重県", "四日市市", "059"},
+	{"滋賀県", "大津市", "077"},
+	{"京都府", "京都市", "075"},
+	{"大阪府", "大阪市", "06"},
+	{"大阪府", "堺市", "072"},
+	{"兵庫県", "神戸市", "078"},
+	{"兵庫県", "姫路市", "079"},
+	{"奈良県", "奈良市", "0742"},
+	{"和歌山県", "和歌山市", "073"},
+	{"鳥取県", "鳥取市", "0857"},
+	{"島根県", "松江市", "0852"},
+	{"岡山県", "岡山市", "086"},
+	{"広島県", "広島市", "082"},
+	{"広島県", "福山市", "084"},
+	{"山口県", "山口市", "083"},
+	{"山口県", "下関市", "083"},
+	{"徳島県", "徳島市", "088"},
+	{"香川県", "高松市", "087"},
+	{"愛媛県", "松山市", "089"},
+	{"高知県", "高知市", "088"},
+	{"福岡県", "福岡市", "092"},
+	{"福岡県", "北九州市", "093"},
+	{"福岡県", "久留米市", "0942"},
+	{"佐賀県", "佐賀市", "0952"},
+	{"長崎県", "長崎市", "095"},
+	{"熊本県", "熊本市", "096"},
+	{"大分県", "大分市", "097"},
+	{"宮崎県", "宮崎市", "0985"},
+	{"鹿児島県", "鹿児島市", "099"},
+	{"鹿児島県", "薩摩川内市", "0996"},
+	{"沖縄県", "那覇市", "098"},
+}
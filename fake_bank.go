@@ -0,0 +1,149 @@
+package csvutil
+
+type fakeBranch struct {
+	code string
+	name string
+}
+
+type fakeBankData struct {
+	code     string
+	name     string
+	branches []fakeBranch
+}
+
+// fakeBanks is bank list for dummy bank account.
+// Branches are small samples, so branch codes may not match actual branches.
+var fakeBanks = []fakeBankData{
+	{
+		code: "0001",
+		name: "みずほ銀行",
+		branches: []fakeBranch{
+			{"001", "東京営業部"},
+			{"004", "丸の内中央支店"},
+			{"020", "日本橋支店"},
+			{"110", "渋谷支店"},
+			{"210", "新宿支店"},
+			{"300", "横浜支店"},
+			{"500", "大阪支店"},
+			{"550", "名古屋支店"},
+		},
+	},
+	{
+		code: "0005",
+		name: "三菱ＵＦＪ銀行",
+		branches: []fakeBranch{
+			{"001", "本店"},
+			{"010", "丸の内支店"},
+			{"130", "渋谷支店"},
+			{"135", "新宿支店"},
+			{"250", "横浜支店"},
+			{"400", "名古屋営業部"},
+			{"450", "大阪営業部"},
+			{"600", "福岡支店"},
+		},
+	},
+	{
+		code: "0009",
+		name: "三井住友銀行",
+		branches: []fakeBranch{
+			{"001", "本店営業部"},
+			{"015", "東京営業部"},
+			{"220", "渋谷支店"},
+			{"225", "新宿西口支店"},
+			{"300", "横浜支店"},
+			{"400", "名古屋支店"},
+			{"101", "大阪本店営業部"},
+			{"700", "福岡支店"},
+		},
+	},
+	{
+		code: "0010",
+		name: "りそな銀行",
+		branches: []fakeBranch{
+			{"101", "大阪営業部"},
+			{"120", "梅田支店"},
+			{"300", "東京営業部"},
+			{"310", "新宿支店"},
+			{"320", "池袋支店"},
+		},
+	},
+	{
+		code: "0017",
+		name: "埼玉りそな銀行",
+		branches: []fakeBranch{
+			{"101", "さいたま営業部"},
+			{"110", "浦和中央支店"},
+			{"120", "川越支店"},
+			{"130", "所沢支店"},
+		},
+	},
+	{
+		code: "0033",
+		name: "ＰａｙＰａｙ銀行",
+		branches: []fakeBranch{
+			{"001", "すずめ支店"},
+			{"002", "はやぶさ支店"},
+			{"003", "ビジネス営業部"},
+		},
+	},
+	{
+		code: "0036",
+		name: "楽天銀行",
+		branches: []fakeBranch{
+			{"201", "ロック支店"},
+			{"202", "ジャズ支店"},
+			{"203", "サンバ支店"},
+			{"204", "タンゴ支店"},
+		},
+	},
+	{
+		code: "0038",
+		name: "住信ＳＢＩネット銀行",
+		branches: []fakeBranch{
+			{"101", "レモン支店"},
+			{"102", "イチゴ支店"},
+			{"106", "ブドウ支店"},
+			{"107", "ミカン支店"},
+		},
+	},
+	{
+		code: "0134",
+		name: "千葉銀行",
+		branches: []fakeBranch{
+			{"001", "本店営業部"},
+			{"010", "船橋支店"},
+			{"020", "松戸支店"},
+			{"030", "柏支店"},
+		},
+	},
+	{
+		code: "0138",
+		name: "横浜銀行",
+		branches: []fakeBranch{
+			{"100", "本店営業部"},
+			{"110", "横浜駅前支店"},
+			{"200", "川崎支店"},
+			{"300", "藤沢支店"},
+		},
+	},
+	{
+		code: "0149",
+		name: "静岡銀行",
+		branches: []fakeBranch{
+			{"001", "本店営業部"},
+			{"010", "静岡駅南支店"},
+			{"100", "浜松営業部"},
+			{"200", "沼津支店"},
+		},
+	},
+	{
+		code: "0177",
+		name: "福岡銀行",
+		branches: []fakeBranch{
+			{"001", "本店営業部"},
+			{"010", "博多駅前支店"},
+			{"020", "天神支店"},
+			{"100", "北九州営業部"},
+		},
+	},
+}
@@ -0,0 +1,90 @@
+package csvutil
+
+type companyWord struct {
+	kanji   string
+	kana    string
+	english string
+}
+
+var (
+	companyFirstWords = []companyWord{
+		{"青葉", "アオバ", "Aoba"},
+		{"朝日", "アサヒ", "Asahi"},
+		{"東", "アズマ", "Azuma"},
+		{"大和", "ヤマト", "Yamato"},
+		{"北斗", "ホクト", "Hokuto"},
+		{"共栄", "キョウエイ", "Kyoei"},
+		{"協和", "キョウワ", "Kyowa"},
+		{"桜", "サクラ", "Sakura"},
+		{"三光", "サンコウ", "Sanko"},
+		{"新星", "シンセイ", "Shinsei"},
+		{"昭和", "ショウワ", "Showa"},
+		{"大成", "タイセイ", "Taisei"},
+		{"中央", "チュウオウ", "Chuo"},
+		{"東洋", "トウヨウ", "Toyo"},
+		{"日本", "ニホン", "Nihon"},
+		{"光", "ヒカリ", "Hikari"},
+		{"富士", "フジ", "Fuji"},
+		{"平和", "ヘイワ", "Heiwa"},
+		{"丸山", "マルヤマ", "Maruyama"},
+		{"緑", "ミドリ", "Midori"},
+		{"山田", "ヤマダ", "Yamada"},
+		{"若葉", "ワカバ", "Wakaba"},
+		{"アーク", "アーク", "Arc"},
+		{"グリーン", "グリーン", "Green"},
+		{"サン", "サン", "Sun"},
+		{"スカイ", "スカイ", "Sky"},
+		{"ネクスト", "ネクスト", "Next"},
+		{"ブルー", "ブルー", "Blue"},
+		{"ユニオン", "ユニオン", "Union"},
+	}
+	companyBusinessWords = []companyWord{
+		{"印刷", "インサツ", "Printing"},
+		{"運輸", "ウンユ", "Transport"},
+		{"化学", "カガク", "Chemical"},
+		{"建設", "ケンセツ", "Construction"},
+		{"工業", "コウギョウ", "Industries"},
+		{"産業", "サンギョウ", "Industry"},
+		{"商事", "ショウジ", "Trading"},
+		{"食品", "ショクヒン", "Foods"},
+		{"製作所", "セイサクショ", "Works"},
+		{"電機", "デンキ", "Electric"},
+		{"不動産", "フドウサン", "Real Estate"},
+		{"物産", "ブッサン", "Bussan"},
+		{"物流", "ブツリュウ", "Logistics"},
+		{"薬品", "ヤクヒン", "Pharmaceutical"},
+		{"システムズ", "システムズ", "Systems"},
+		{"ソリューションズ", "ソリューションズ", "Solutions"},
+		{"テクノロジー", "テクノロジー", "Technology"},
+		{"ホールディングス", "ホールディングス", "Holdings"},
+	}
+	companyTypes = []companyWord{
+		{"株式会社", "カブシキガイシャ", "Co., Ltd."},
+		{"合同会社", "ゴウドウガイシャ", "LLC"},
+		{"有限会社", "ユウゲンガイシャ", "Ltd."},
+	}
+	departments = []companyWord{
+		{"営業部", "エイギョウブ", "Sales Department"},
+		{"総務部", "ソウムブ", "General Affairs Department"},
+		{"人事部", "ジンジブ", "Human Resources Department"},
+		{"経理部", "ケイリブ", "Accounting Department"},
+		{"開発部", "カイハツブ", "Development Department"},
+		{"企画部", "キカクブ", "Planning Department"},
+		{"広報部", "コウホウブ", "Public Relations Department"},
+		{"情報システム部", "ジョウホウシステムブ", "IT Department"},
+		{"法務部", "ホウムブ", "Legal Department"},
+		{"製造部", "セイゾウブ", "Manufacturing Department"},
+		{"購買部", "コウバイブ", "Purchasing Department"},
+		{"品質管理部", "ヒンシツカンリブ", "Quality Control Department"},
+	}
+	jobTitles = []companyWord{
+		{"代表取締役社長", "ダイヒョウトリシマリヤクシャチョウ", "President"},
+		{"取締役", "トリシマリヤク", "Director"},
+		{"部長", "ブチョウ", "General Manager"},
+		{"次長", "ジチョウ", "Deputy General Manager"},
+		{"課長", "カチョウ", "Manager"},
+		{"係長", "カカリチョウ", "Assistant Manager"},
+		{"主任", "シュニン", "Chief"},
+		{"担当", "タントウ", "Staff"},
+	}
+)
@@ -0,0 +1,270 @@
+package csvutil
+
+type postalAddress struct {
+	zipCode    string
+	prefecture string
+	city       string
+	town       string
+}
+
+// fakePostalAddresses is sample of postal code data covering all prefectures.
+// Zip code, prefecture, city and town of each entry are matched.
+var fakePostalAddresses = []postalAddress{
+	{"060-0001", "北海道", "札幌市中央区", "北一条西"},
+	{"060-0042", "北海道", "札幌市中央区", "大通西"},
+	{"060-0061", "北海道", "札幌市中央区", "南一条西"},
+	{"060-0806", "北海道", "札幌市北区", "北六条西"},
+	{"040-0011", "北海道", "函館市", "本町"},
+	{"070-0031", "北海道", "旭川市", "一条通"},
+	{"080-0010", "北海道", "帯広市", "大通南"},
+	{"085-0015", "北海道", "釧路市", "北大通"},
+	{"047-0032", "北海道", "小樽市", "稲穂"},
+	{"030-0801", "青森県", "青森市", "新町"},
+	{"030-0861", "青森県", "青森市", "長島"},
+	{"036-8004", "青森県", "弘前市", "大町"},
+	{"031-0042", "青森県", "八戸市", "十三日町"},
+	{"020-0021", "岩手県", "盛岡市", "中央通"},
+	{"020-0034", "岩手県", "盛岡市", "盛岡駅前通"},
+	{"024-0061", "岩手県", "北上市", "大通り"},
+	{"026-0024", "岩手県", "釜石市", "大町"},
+	{"980-0021", "宮城県", "仙台市青葉区", "中央"},
+	{"980-0811", "宮城県", "仙台市青葉区", "一番町"},
+	{"983-0852", "宮城県", "仙台市宮城野区", "榴岡"},
+	{"985-0002", "宮城県", "塩竈市", "海岸通"},
+	{"986-0825", "宮城県", "石巻市", "穀町"},
+	{"010-0001", "秋田県", "秋田市", "中通"},
+	{"010-0951", "秋田県", "秋田市", "山王"},
+	{"017-0044", "秋田県", "大館市", "御成町"},
+	{"013-0036", "秋田県", "横手市", "駅前町"},
+	{"990-0039", "山形県", "山形市", "香澄町"},
+	{"990-0042", "山形県", "山形市", "七日町"},
+	{"997-0035", "山形県", "鶴岡市", "馬場町"},
+	{"998-0044", "山形県", "酒田市", "中町"},
+	{"960-8041", "福島県", "福島市", "大町"},
+	{"960-8031", "福島県", "福島市", "栄町"},
+	{"963-8002", "福島県", "郡山市", "駅前"},
+	{"965-0871", "福島県", "会津若松市", "栄町"},
+	{"970-8026", "福島県", "いわき市", "平"},
+	{"310-0011", "茨城県", "水戸市", "三の丸"},
+	{"310-0015", "茨城県", "水戸市", "宮町"},
+	{"305-0031", "茨城県", "つくば市", "吾妻"},
+	{"317-0073", "茨城県", "日立市", "幸町"},
+	{"300-0043", "茨城県", "土浦市", "中央"},
+	{"320-0806", "栃木県", "宇都宮市", "中央"},
+	{"320-0026", "栃木県", "宇都宮市", "馬場通り"},
+	{"326-0814", "栃木県", "足利市", "通"},
+	{"321-1261", "栃木県", "日光市", "今市"},
+	{"328-0037", "栃木県", "栃木市", "倭町"},
+	{"371-0026", "群馬県", "前橋市", "大手町"},
+	{"371-0023", "群馬県", "前橋市", "本町"},
+	{"370-0841", "群馬県", "高崎市", "栄町"},
+	{"376-0031", "群馬県", "桐生市", "本町"},
+	{"372-0042", "群馬県", "伊勢崎市", "中央町"},
+	{"330-0063", "埼玉県", "さいたま市浦和区", "高砂"},
+	{"330-0853", "埼玉県", "さいたま市大宮区", "錦町"},
+	{"350-0043", "埼玉県", "川越市", "新富町"},
+	{"332-0012", "埼玉県", "川口市", "本町"},
+	{"340-0022", "埼玉県", "草加市", "瀬崎"},
+	{"360-0037", "埼玉県", "熊谷市", "筑波"},
+	{"260-0013", "千葉県", "千葉市中央区", "中央"},
+	{"261-8501", "千葉県", "千葉市美浜区", "中瀬"},
+	{"273-0005", "千葉県", "船橋市", "本町"},
+	{"279-0031", "千葉県", "浦安市", "舞浜"},
+	{"271-0091", "千葉県", "松戸市", "本町"},
+	{"286-0033", "千葉県", "成田市", "花崎町"},
+	{"100-0005", "東京都", "千代田区", "丸の内"},
+	{"100-0014", "東京都", "千代田区", "永田町"},
+	{"101-0021", "東京都", "千代田区", "外神田"},
+	{"104-0061", "東京都", "中央区", "銀座"},
+	{"103-0027", "東京都", "中央区", "日本橋"},
+	{"105-0011", "東京都", "港区", "芝公園"},
+	{"106-0032", "東京都", "港区", "六本木"},
+	{"108-0075", "東京都", "港区", "港南"},
+	{"111-0032", "東京都", "台東区", "浅草"},
+	{"110-0005", "東京都", "台東区", "上野"},
+	{"113-0033", "東京都", "文京区", "本郷"},
+	{"131-0045", "東京都", "墨田区", "押上"},
+	{"135-0064", "東京都", "江東区", "青海"},
+	{"140-0011", "東京都", "品川区", "東大井"},
+	{"141-0021", "東京都", "品川区", "上大崎"},
+	{"144-0041", "東京都", "大田区", "羽田空港"},
+	{"150-0002", "東京都", "渋谷区", "渋谷"},
+	{"150-0001", "東京都", "渋谷区", "神宮前"},
+	{"154-0004", "東京都", "世田谷区", "太子堂"},
+	{"160-0022", "東京都", "新宿区", "新宿"},
+	{"169-0075", "東京都", "新宿区", "高田馬場"},
+	{"164-0001", "東京都", "中野区", "中野"},
+	{"166-0003", "東京都", "杉並区", "高円寺南"},
+	{"170-0013", "東京都", "豊島区", "東池袋"},
+	{"114-0002", "東京都", "北区", "王子"},
+	{"120-0034", "東京都", "足立区", "千住"},
+	{"180-0004", "東京都", "武蔵野市", "吉祥寺本町"},
+	{"190-0012", "東京都", "立川市", "曙町"},
+	{"192-0083", "東京都", "八王子市", "旭町"},
+	{"206-0033", "東京都", "多摩市", "落合"},
+	{"210-0007", "神奈川県", "川崎市川崎区", "駅前本町"},
+	{"211-0004", "神奈川県", "川崎市中原区", "新丸子東"},
+	{"220-0012", "神奈川県", "横浜市西区", "みなとみらい"},
+	{"231-0023", "神奈川県", "横浜市中区", "山下町"},
+	{"222-0033", "神奈川県", "横浜市港北区", "新横浜"},
+	{"248-0006", "神奈川県", "鎌倉市", "小町"},
+	{"238-0041", "神奈川県", "横須賀市", "本町"},
+	{"251-0055", "神奈川県", "藤沢市", "南藤沢"},
+	{"250-0011", "神奈川県", "小田原市", "栄町"},
+	{"950-0087", "新潟県", "新潟市中央区", "東大通"},
+	{"951-8068", "新潟県", "新潟市中央区", "上大川前通"},
+	{"940-0062", "新潟県", "長岡市", "大手通"},
+	{"943-0832", "新潟県", "上越市", "本町"},
+	{"930-0003", "富山県", "富山市", "桜町"},
+	{"930-0083", "富山県", "富山市", "総曲輪"},
+	{"933-0023", "富山県", "高岡市", "末広町"},
+	{"937-0051", "富山県", "魚津市", "駅前新町"},
+	{"920-0962", "石川県", "金沢市", "広坂"},
+	{"920-0853", "石川県", "金沢市", "本町"},
+	{"923-0801", "石川県", "小松市", "園町"},
+	{"928-0001", "石川県", "輪島市", "河井町"},
+	{"910-0006", "福井県", "福井市", "中央"},
+	{"910-0005", "福井県", "福井市", "大手"},
+	{"914-0063", "福井県", "敦賀市", "神楽町"},
+	{"917-0069", "福井県", "小浜市", "小浜白鬚"},
+	{"400-0031", "山梨県", "甲府市", "丸の内"},
+	{"400-0032", "山梨県", "甲府市", "中央"},
+	{"403-0004", "山梨県", "富士吉田市", "下吉田"},
+	{"405-0018", "山梨県", "山梨市", "上神内川"},
+	{"380-0823", "長野県", "長野市", "南千歳"},
+	{"380-0836", "長野県", "長野市", "南県町"},
+	{"390-0811", "長野県", "松本市", "中央"},
+	{"392-0004", "長野県", "諏訪市", "諏訪"},
+	{"386-0012", "長野県", "上田市", "中央"},
+	{"500-8856", "岐阜県", "岐阜市", "橋本町"},
+	{"500-8833", "岐阜県", "岐阜市", "神田町"},
+	{"506-0011", "岐阜県", "高山市", "本町"},
+	{"503-0887", "岐阜県", "大垣市", "郭町"},
+	{"420-0853", "静岡県", "静岡市葵区", "追手町"},
+	{"420-0851", "静岡県", "静岡市葵区", "黒金町"},
+	{"430-0926", "静岡県", "浜松市中央区", "砂山町"},
+	{"410-0801", "静岡県", "沼津市", "大手町"},
+	{"413-0011", "静岡県", "熱海市", "田原本町"},
+	{"450-0002", "愛知県", "名古屋市中村区", "名駅"},
+	{"460-0008", "愛知県", "名古屋市中区", "栄"},
+	{"460-0002", "愛知県", "名古屋市中区", "丸の内"},
+	{"464-0819", "愛知県", "名古屋市千種区", "四谷通"},
+	{"471-0025", "愛知県", "豊田市", "西町"},
+	{"440-0888", "愛知県", "豊橋市", "駅前大通"},
+	{"444-0043", "愛知県", "岡崎市", "唐沢町"},
+	{"514-0004", "三重県", "津市", "栄町"},
+	{"514-0009", "三重県", "津市", "羽所町"},
+	{"510-0086", "三重県", "四日市市", "諏訪栄町"},
+	{"516-0037", "三重県", "伊勢市", "岩渕"},
+	{"513-0801", "三重県", "鈴鹿市", "神戸"},
+	{"520-0044", "滋賀県", "大津市", "京町"},
+	{"520-0055", "滋賀県", "大津市", "春日町"},
+	{"522-0074", "滋賀県", "彦根市", "大東町"},
+	{"525-0032", "滋賀県", "草津市", "大路"},
+	{"526-0057", "滋賀県", "長浜市", "北船町"},
+	{"600-8216", "京都府", "京都市下京区", "東塩小路町"},
+	{"604-8005", "京都府", "京都市中京区", "恵比須町"},
+	{"605-0073", "京都府", "京都市東山区", "祇園町北側"},
+	{"602-8041", "京都府", "京都市上京区", "烏丸通下長者町上る龍前町"},
+	{"611-0021", "京都府", "宇治市", "宇治"},
+	{"620-0035", "京都府", "福知山市", "内記"},
+	{"530-0001", "大阪府", "大阪市北区", "梅田"},
+	{"530-0017", "大阪府", "大阪市北区", "角田町"},
+	{"542-0076", "大阪府", "大阪市中央区", "難波"},
+	{"540-0008", "大阪府", "大阪市中央区", "大手前"},
+	{"556-0011", "大阪府", "大阪市浪速区", "難波中"},
+	{"545-0052", "大阪府", "大阪市阿倍野区", "阿倍野筋"},
+	{"590-0028", "大阪府", "堺市堺区", "三国ヶ丘御幸通"},
+	{"560-0021", "大阪府", "豊中市", "本町"},
+	{"569-0071", "大阪府", "高槻市", "城北町"},
+	{"573-0032", "大阪府", "枚方市", "岡東町"},
+	{"650-0001", "兵庫県", "神戸市中央区", "加納町"},
+	{"650-0021", "兵庫県", "神戸市中央区", "三宮町"},
+	{"651-0088", "兵庫県", "神戸市中央区", "小野柄通"},
+	{"670-0012", "兵庫県", "姫路市", "本町"},
+	{"660-0861", "兵庫県", "尼崎市", "御園町"},
+	{"662-0918", "兵庫県", "西宮市", "六湛寺町"},
+	{"664-0851", "兵庫県", "伊丹市", "中央"},
+	{"630-8213", "奈良県", "奈良市", "登大路町"},
+	{"630-8226", "奈良県", "奈良市", "小西町"},
+	{"634-0078", "奈良県", "橿原市", "八木町"},
+	{"639-1160", "奈良県", "大和郡山市", "北郡山町"},
+	{"640-8156", "和歌山県", "和歌山市", "七番丁"},
+	{"640-8331", "和歌山県", "和歌山市", "美園町"},
+	{"646-0031", "和歌山県", "田辺市", "湊"},
+	{"647-0044", "和歌山県", "新宮市", "神倉"},
+	{"680-0011", "鳥取県", "鳥取市", "東町"},
+	{"680-0833", "鳥取県", "鳥取市", "末広温泉町"},
+	{"683-0067", "鳥取県", "米子市", "東町"},
+	{"682-0022", "鳥取県", "倉吉市", "上井町"},
+	{"690-0887", "島根県", "松江市", "殿町"},
+	{"690-0003", "島根県", "松江市", "朝日町"},
+	{"693-0001", "島根県", "出雲市", "今市町"},
+	{"697-0027", "島根県", "浜田市", "殿町"},
+	{"700-0024", "岡山県", "岡山市北区", "駅元町"},
+	{"700-0822", "岡山県", "岡山市北区", "表町"},
+	{"710-0055", "岡山県", "倉敷市", "阿知"},
+	{"708-0022", "岡山県", "津山市", "山下"},
+	{"730-0011", "広島県", "広島市中区", "基町"},
+	{"730-0035", "広島県", "広島市中区", "本通"},
+	{"732-0822", "広島県", "広島市南区", "松原町"},
+	{"720-0065", "広島県", "福山市", "東桜町"},
+	{"737-0045", "広島県", "呉市", "本通"},
+	{"722-0035", "広島県", "尾道市", "土堂"},
+	{"753-0071", "山口県", "山口市", "滝町"},
+	{"753-0074", "山口県", "山口市", "中央"},
+	{"750-0006", "山口県", "下関市", "南部町"},
+	{"755-0029", "山口県", "宇部市", "新天町"},
+	{"745-0034", "山口県", "周南市", "御幸通"},
+	{"770-0941", "徳島県", "徳島市", "万代町"},
+	{"770-0831", "徳島県", "徳島市", "寺島本町西"},
+	{"774-0030", "徳島県", "阿南市", "富岡町"},
+	{"772-0003", "徳島県", "鳴門市", "撫養町南浜"},
+	{"760-0017", "香川県", "高松市", "番町"},
+	{"760-0011", "香川県", "高松市", "浜ノ町"},
+	{"763-0034", "香川県", "丸亀市", "大手町"},
+	{"768-0060", "香川県", "観音寺市", "観音寺町"},
+	{"790-0001", "愛媛県", "松山市", "一番町"},
+	{"790-0012", "愛媛県", "松山市", "湊町"},
+	{"794-0028", "愛媛県", "今治市", "北宝来町"},
+	{"798-0040", "愛媛県", "宇和島市", "中央町"},
+	{"780-0850", "高知県", "高知市", "丸ノ内"},
+	{"780-0056", "高知県", "高知市", "北本町"},
+	{"781-5101", "高知県", "高知市", "布師田"},
+	{"787-0012", "高知県", "四万十市", "右山五月町"},
+	{"810-0001", "福岡県", "福岡市中央区", "天神"},
+	{"812-0012", "福岡県", "福岡市博多区", "博多駅中央街"},
+	{"812-0027", "福岡県", "福岡市博多区", "下川端町"},
+	{"802-0001", "福岡県", "北九州市小倉北区", "浅野"},
+	{"830-0023", "福岡県", "久留米市", "中央町"},
+	{"838-0141", "福岡県", "小郡市", "小郡"},
+	{"840-0041", "佐賀県", "佐賀市", "城内"},
+	{"840-0801", "佐賀県", "佐賀市", "駅前中央"},
+	{"847-0011", "佐賀県", "唐津市", "栄町"},
+	{"843-0022", "佐賀県", "武雄市", "武雄町武雄"},
+	{"850-0057", "長崎県", "長崎市", "大黒町"},
+	{"850-0861", "長崎県", "長崎市", "江戸町"},
+	{"857-0863", "長崎県", "佐世保市", "三浦町"},
+	{"854-0001", "長崎県", "諫早市", "福田町"},
+	{"860-0806", "熊本県", "熊本市中央区", "花畑町"},
+	{"860-0047", "熊本県", "熊本市西区", "春日"},
+	{"866-0862", "熊本県", "八代市", "松江城町"},
+	{"868-0005", "熊本県", "人吉市", "五日町"},
+	{"870-0022", "大分県", "大分市", "大手町"},
+	{"870-0035", "大分県", "大分市", "中央町"},
+	{"874-0920", "大分県", "別府市", "北浜"},
+	{"871-0058", "大分県", "中津市", "豊田町"},
+	{"880-0805", "宮崎県", "宮崎市", "橘通東"},
+	{"880-0812", "宮崎県", "宮崎市", "高千穂通"},
+	{"885-0071", "宮崎県", "都城市", "中町"},
+	{"882-0044", "宮崎県", "延岡市", "幸町"},
+	{"890-0053", "鹿児島県", "鹿児島市", "中央町"},
+	{"892-0842", "鹿児島県", "鹿児島市", "東千石町"},
+	{"899-5431", "鹿児島県", "姶良市", "西餅田"},
+	{"895-0024", "鹿児島県", "薩摩川内市", "鳥追町"},
+	{"900-0006", "沖縄県", "那覇市", "おもろまち"},
+	{"900-0014", "沖縄県", "那覇市", "松尾"},
+	{"904-0004", "沖縄県", "沖縄市", "中央"},
+	{"906-0012", "沖縄県", "宮古島市", "平良西里"},
+	{"907-0004", "沖縄県", "石垣市", "登野城"},
+}
@@ -0,0 +1,198 @@
+package csvutil
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FillOption is option holder for Fill.
+type FillOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// ColumnSyms header or column index list. (default all columns)
+	ColumnSyms []string
+	// Value for filling.
+	// On Down, Up or From, it is used when no value is found.
+	Value string
+	// Fill with previous non-empty value.
+	Down bool
+	// Fill with next non-empty value.
+	Up bool
+	// From is column symbol which value is used for filling.
+	From string
+	// Treat cells that have only spaces (half or full width) as empty.
+	Space bool
+}
+
+func (o FillOption) validate() error {
+	if o.NoHeader {
+		for _, c := range o.ColumnSyms {
+			if !isDigit(c) {
+				return errors.New("not number column symbol")
+			}
+		}
+		if !isEmptyOrDigit(o.From) {
+			return errors.New("not number column symbol")
+		}
+	}
+	n := 0
+	for _, b := range []bool{o.Down, o.Up, o.From != ""} {
+		if b {
+			n++
+		}
+	}
+	if n > 1 {
+		return errors.New("down, up and from cannot be used together")
+	}
+	if n == 0 && o.Value == "" {
+		return errors.New("no value")
+	}
+	return nil
+}
+
+func (o FillOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+func (o FillOption) isEmpty(s string) bool {
+	if o.Space {
+		return strings.Trim(s, " 　") == ""
+	}
+	return s == ""
+}
+
+// Fill empty cells of given columns.
+func Fill(r io.Reader, w io.Writer, o FillOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	var cols columns
+	var from *column
+	setup := func(hdr []string) error {
+		cols = newUniqueColumns(o.ColumnSyms, hdr)
+		from = newColumnWithIndex(o.From, hdr)
+		return append(columns{from}, cols...).err()
+	}
+	targets := func(rec []string) []int {
+		idxs := make([]int, 0, len(rec))
+		for i := range rec {
+			if len(cols) == 0 || containsColumnIndex(cols, i) {
+				idxs = append(idxs, i)
+			}
+		}
+		return idxs
+	}
+
+	csvp := NewCSVProcessor(cr, cw)
+	if o.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			return setup(nil)
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			return hdr, setup(hdr)
+		})
+	}
+
+	if o.Up {
+		f := &upFiller{opt: o, writer: cw, pending: make(map[int][]int)}
+		csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+			f.add(rec, targets(rec))
+			return nil, nil
+		})
+		if err := csvp.Process(); err != nil {
+			return err
+		}
+		f.flushAll()
+		return nil
+	}
+
+	prevs := make(map[int]string)
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		for _, i := range targets(rec) {
+			if !o.isEmpty(rec[i]) {
+				prevs[i] = rec[i]
+				continue
+			}
+			v := o.Value
+			if o.Down {
+				if p, ok := prevs[i]; ok {
+					v = p
+				}
+			} else if from.index != -1 && !o.isEmpty(rec[from.index]) {
+				v = rec[from.index]
+			}
+			rec[i] = v
+		}
+		return rec, nil
+	})
+
+	return csvp.Process()
+}
+
+// upFiller buffers records until next non-empty value of each column is found.
+type upFiller struct {
+	opt    FillOption
+	writer *csv.Writer
+	buf    [][]string
+	// base is row number of first buffered record.
+	base int
+	// pending is map of column index and row numbers that wait for value.
+	pending map[int][]int
+}
+
+func (f *upFiller) add(rec []string, idxs []int) {
+	n := f.base + len(f.buf)
+	f.buf = append(f.buf, rec)
+	for _, i := range idxs {
+		if f.opt.isEmpty(rec[i]) {
+			f.pending[i] = append(f.pending[i], n)
+			continue
+		}
+		for _, row := range f.pending[i] {
+			f.buf[row-f.base][i] = rec[i]
+		}
+		delete(f.pending, i)
+	}
+	f.flush()
+}
+
+// flush writes records before first pending row.
+func (f *upFiller) flush() {
+	end := f.base + len(f.buf)
+	for _, rows := range f.pending {
+		if rows[0] < end {
+			end = rows[0]
+		}
+	}
+	for _, rec := range f.buf[:end-f.base] {
+		f.writer.Write(rec)
+	}
+	f.buf = f.buf[end-f.base:]
+	f.base = end
+}
+
+func (f *upFiller) flushAll() {
+	for i, rows := range f.pending {
+		for _, row := range rows {
+			f.buf[row-f.base][i] = f.opt.Value
+		}
+	}
+	f.pending = make(map[int][]int)
+	f.flush()
+}
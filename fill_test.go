@@ -0,0 +1,270 @@
+package csvutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFillWithoutValue(t *testing.T) {
+	s := `aaa,bbb
+1,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FillOption{}
+
+	if err := Fill(r, w, o); err == nil {
+		t.Fatal("Fill without value should raise error.")
+	}
+}
+
+func TestFillWithDownAndUp(t *testing.T) {
+	s := `aaa,bbb
+1,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FillOption{
+		Down: true,
+		Up:   true,
+	}
+
+	if err := Fill(r, w, o); err == nil {
+		t.Fatal("Fill with down and up should raise error.")
+	}
+}
+
+func TestFillWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := `1,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FillOption{
+		NoHeader:   true,
+		ColumnSyms: []string{"bbb"},
+		Value:      "x",
+	}
+
+	if err := Fill(r, w, o); err == nil {
+		t.Fatal("Fill with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestFillWithNoHeaderButFromNotNumber(t *testing.T) {
+	s := `1,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FillOption{
+		NoHeader: true,
+		From:     "aaa",
+	}
+
+	if err := Fill(r, w, o); err == nil {
+		t.Fatal("Fill with not number from column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestFillWithUnknownFrom(t *testing.T) {
+	s := `aaa,bbb
+1,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FillOption{
+		ColumnSyms: []string{"bbb"},
+		From:       "ccc",
+	}
+
+	if err := Fill(r, w, o); err == nil {
+		t.Fatal("Fill with unknown from column should raise error.")
+	}
+}
+
+func TestFillWithBrokenCSV(t *testing.T) {
+	s := `aaa,bbb
+1,
+2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FillOption{
+		Up: true,
+	}
+
+	if err := Fill(r, w, o); err == nil {
+		t.Fatal("Fill with broken csv should raise error.")
+	}
+}
+
+func TestFillWithValue(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,,
+, ,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FillOption{
+		ColumnSyms: []string{"aaa", "bbb"},
+		Value:      "N/A",
+	}
+
+	if err := Fill(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+1,N/A,
+N/A," ",3
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFillWithSpace(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,　,
+, ,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FillOption{
+		Value: "-",
+		Space: true,
+	}
+
+	if err := Fill(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+1,-,-
+-,-,3
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFillWithDown(t *testing.T) {
+	s := `group,name
+,x
+A,a
+,b
+,c
+B,d
+,e
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FillOption{
+		ColumnSyms: []string{"group"},
+		Down:       true,
+		Value:      "?",
+	}
+
+	if err := Fill(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `group,name
+?,x
+A,a
+A,b
+A,c
+B,d
+B,e
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFillWithUp(t *testing.T) {
+	s := `aaa,bbb
+,1
+,
+a,
+,2
+b,3
+,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FillOption{
+		Up: true,
+	}
+
+	if err := Fill(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb
+a,1
+a,2
+a,2
+b,2
+b,3
+,
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFillWithUpAndValue(t *testing.T) {
+	s := `1,
+,
+2,x
+,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FillOption{
+		NoHeader: true,
+		Up:       true,
+		Value:    "z",
+	}
+
+	if err := Fill(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `1,x
+2,x
+2,x
+z,z
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFillWithFrom(t *testing.T) {
+	s := `name,nickname
+Taro,
+Hanako,Hana
+,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FillOption{
+		ColumnSyms: []string{"nickname"},
+		From:       "name",
+		Value:      "unknown",
+	}
+
+	if err := Fill(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `name,nickname
+Taro,Taro
+Hanako,Hana
+,unknown
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
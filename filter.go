@@ -20,10 +20,21 @@ type FilterOption struct {
 	ColumnSyms []string
 	// Target pattern
 	Pattern string
+	// Target values (matched exactly)
+	Patterns []string
 	// Use regexp
-	Regexp  bool
-	regex   *regexp.Regexp
-	matches func(string) bool
+	Regexp bool
+	// Match whole value
+	Exact bool
+	// Ignore case on matching
+	IgnoreCase bool
+	// All target columns should match
+	All bool
+	// Output lines that do not match
+	Invert   bool
+	regex    *regexp.Regexp
+	valueSet map[string]struct{}
+	matches  func(string) bool
 }
 
 func (o *FilterOption) validate() error {
@@ -34,26 +45,91 @@ func (o *FilterOption) validate() error {
 			}
 		}
 	}
-	if o.Pattern == "" {
+	if o.Pattern == "" && len(o.Patterns) == 0 {
 		return errors.New("no pattern")
 	}
-	if o.Regexp {
-		r, err := regexp.Compile(o.Pattern)
+	var patternMatches func(string) bool
+	if o.Pattern == "" {
+		patternMatches = func(s string) bool {
+			return false
+		}
+	} else if o.Regexp {
+		p := o.Pattern
+		if o.Exact {
+			p = "^(?:" + p + ")$"
+		}
+		if o.IgnoreCase {
+			p = "(?i)" + p
+		}
+		r, err := regexp.Compile(p)
 		if err != nil {
 			return err
 		}
 		o.regex = r
-		o.matches = func(s string) bool {
+		patternMatches = func(s string) bool {
 			return o.regex.MatchString(s)
 		}
+	} else if o.Exact {
+		patternMatches = func(s string) bool {
+			if o.IgnoreCase {
+				return strings.EqualFold(s, o.Pattern)
+			}
+			return s == o.Pattern
+		}
 	} else {
-		o.matches = func(s string) bool {
-			return strings.Contains(s, o.Pattern)
+		p := o.Pattern
+		if o.IgnoreCase {
+			p = strings.ToLower(p)
 		}
+		patternMatches = func(s string) bool {
+			if o.IgnoreCase {
+				return strings.Contains(strings.ToLower(s), p)
+			}
+			return strings.Contains(s, p)
+		}
+	}
+
+	o.valueSet = make(map[string]struct{}, len(o.Patterns))
+	for _, p := range o.Patterns {
+		o.valueSet[o.normalizeValue(p)] = struct{}{}
+	}
+	o.matches = func(s string) bool {
+		if _, ok := o.valueSet[o.normalizeValue(s)]; ok {
+			return true
+		}
+		return patternMatches(s)
 	}
 	return nil
 }
 
+func (o *FilterOption) normalizeValue(s string) string {
+	if o.IgnoreCase {
+		return strings.ToLower(s)
+	}
+	return s
+}
+
+func (o *FilterOption) matchesRecord(rec []string, cols columns) bool {
+	vals := rec
+	if len(cols) != 0 {
+		vals = make([]string, len(cols))
+		for i, col := range cols {
+			vals[i] = rec[col.index]
+		}
+	}
+
+	for _, s := range vals {
+		m := o.matches(s)
+		if o.All && !m {
+			return false
+		}
+		if !o.All && m {
+			return true
+		}
+	}
+	return o.All && len(vals) != 0
+}
+
 func (o FilterOption) outputEncoding() string {
 	if o.OutputEncoding != "" {
 		return o.OutputEncoding
@@ -86,19 +162,8 @@ func Filter(r io.Reader, w io.Writer, o FilterOption) error {
 		})
 	}
 	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
-		if len(cols) == 0 {
-			for _, s := range rec {
-				if len(cols) == 0 && o.matches(s) {
-					return rec, nil
-				}
-			}
-			return nil, nil
-		}
-
-		for _, col := range cols {
-			if o.matches(rec[col.index]) {
-				return rec, nil
-			}
+		if opt.matchesRecord(rec, cols) != opt.Invert {
+			return rec, nil
 		}
 		return nil, nil
 	})
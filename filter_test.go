@@ -226,3 +226,240 @@ D4,E5,F6
 	}
 
 }
+
+func TestFilterWithInvert(t *testing.T) {
+	s := `aaa,bbb,ccc
+A1,B2,C3
+D4,E5,F6
+G7,H8,I4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FilterOption{
+		Pattern: "4",
+		Invert:  true,
+	}
+
+	if err := Filter(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+A1,B2,C3
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFilterWithExact(t *testing.T) {
+	s := `aaa,bbb,ccc
+A1,B2,C3
+D4,E5,F6
+A,H8,I4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FilterOption{
+		Pattern:    "A",
+		ColumnSyms: []string{"aaa"},
+		Exact:      true,
+	}
+
+	if err := Filter(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+A,H8,I4
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFilterWithExactRegexp(t *testing.T) {
+	s := `aaa,bbb,ccc
+A1,B2,C3
+D4,E5,F6
+A12,H8,I4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FilterOption{
+		Pattern:    "[A-D]\\d",
+		ColumnSyms: []string{"aaa"},
+		Regexp:     true,
+		Exact:      true,
+	}
+
+	if err := Filter(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+A1,B2,C3
+D4,E5,F6
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFilterWithIgnoreCase(t *testing.T) {
+	s := `aaa,bbb,ccc
+a1,b2,c3
+D4,E5,F6
+G7,H8,I4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FilterOption{
+		Pattern:    "A",
+		IgnoreCase: true,
+	}
+
+	if err := Filter(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+a1,b2,c3
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFilterWithIgnoreCaseRegexp(t *testing.T) {
+	s := `aaa,bbb,ccc
+a1,b2,c3
+D4,E5,F6
+G7,H8,I4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FilterOption{
+		Pattern:    "[A-D]",
+		Regexp:     true,
+		IgnoreCase: true,
+	}
+
+	if err := Filter(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+a1,b2,c3
+D4,E5,F6
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFilterWithAll(t *testing.T) {
+	s := `aaa,bbb,ccc
+A4,B2,C3
+D4,E4,F6
+G7,H4,I4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FilterOption{
+		Pattern:    "4",
+		ColumnSyms: []string{"aaa", "bbb"},
+		All:        true,
+	}
+
+	if err := Filter(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+D4,E4,F6
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFilterWithAllWithoutColumnSyms(t *testing.T) {
+	s := `aaa,bbb,ccc
+A4,B2,C3
+D4,E4,F4
+G7,H4,I4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FilterOption{
+		Pattern: "4",
+		All:     true,
+	}
+
+	if err := Filter(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+D4,E4,F4
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFilterWithPatterns(t *testing.T) {
+	s := `aaa,bbb,ccc
+C001,B2,C3
+C002,E5,F6
+C0021,H8,I4
+c003,H8,I4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FilterOption{
+		Patterns:   []string{"C002", "C003"},
+		ColumnSyms: []string{"aaa"},
+	}
+
+	if err := Filter(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+C002,E5,F6
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestFilterWithPatternsAndIgnoreCaseAndInvert(t *testing.T) {
+	s := `aaa,bbb,ccc
+C001,B2,C3
+C002,E5,F6
+C0021,H8,I4
+c003,H8,I4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := FilterOption{
+		Patterns:   []string{"C002", "C003"},
+		ColumnSyms: []string{"aaa"},
+		IgnoreCase: true,
+		Invert:     true,
+	}
+
+	if err := Filter(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+C001,B2,C3
+C0021,H8,I4
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
@@ -0,0 +1,139 @@
+package csvutil
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// LookupOption is option holder for Lookup.
+type LookupOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// Encoding of reference file. (default same as Encoding)
+	ReferenceEncoding string
+	// Key column symbol of source file.
+	Key string
+	// Key column symbol of reference file.
+	ReferenceKey string
+	// TakeSyms is column symbol list of reference file that are appended to source.
+	TakeSyms []string
+	// Default value for missed key.
+	Default string
+	// Raise error on missed key.
+	FailOnMiss bool
+}
+
+func (o LookupOption) validate() error {
+	if o.Key == "" {
+		return errors.New("no key")
+	}
+	if o.NoHeader && !isDigit(o.Key) {
+		return errors.New("not number column symbol")
+	}
+	if o.ReferenceKey == "" {
+		return errors.New("no reference key")
+	}
+	if len(o.TakeSyms) == 0 {
+		return errors.New("no taken column")
+	}
+	return nil
+}
+
+func (o LookupOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+func (o LookupOption) referenceEncoding() string {
+	if o.ReferenceEncoding != "" {
+		return o.ReferenceEncoding
+	}
+	return o.Encoding
+}
+
+// Lookup values from reference CSV by key, and append them to source CSV.
+// Reference CSV should have header line.
+func Lookup(r io.Reader, w io.Writer, ref io.Reader, o LookupOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	hdr, table, err := loadReference(ref, o)
+	if err != nil {
+		return err
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	var key *column
+	csvp := NewCSVProcessor(cr, cw)
+	if o.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			key = newColumnWithIndex(o.Key, nil)
+			return key.err
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(h []string) ([]string, error) {
+			key = newColumnWithIndex(o.Key, h)
+			if key.err != nil {
+				return nil, key.err
+			}
+			return append(h, hdr...), nil
+		})
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		vals, ok := table[rec[key.index]]
+		if !ok {
+			if o.FailOnMiss {
+				return nil, errors.Errorf("key not found in reference: %s", rec[key.index])
+			}
+			vals = make([]string, len(hdr))
+			for i := range vals {
+				vals[i] = o.Default
+			}
+		}
+		return append(rec, vals...), nil
+	})
+
+	return csvp.Process()
+}
+
+// loadReference reads reference CSV into hash map of key and taken values.
+// When the same key appears several times, the first one is used.
+func loadReference(ref io.Reader, o LookupOption) ([]string, map[string][]string, error) {
+	cr, _ := reader(ref, o.referenceEncoding())
+	hdr, err := cr.Read()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot read reference header")
+	}
+	key := newColumnWithIndex(o.ReferenceKey, hdr)
+	takes := newColumnsWithIndexes(o.TakeSyms, hdr)
+	if err := append(columns{key}, takes...).err(); err != nil {
+		return nil, nil, errors.Wrap(err, "invalid reference column")
+	}
+
+	table := make(map[string][]string)
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "cannot read reference")
+		}
+		if _, ok := table[rec[key.index]]; ok {
+			continue
+		}
+		table[rec[key.index]] = extractFromRecord(rec, takes)
+	}
+	return extractFromRecord(hdr, takes), table, nil
+}
@@ -0,0 +1,247 @@
+package csvutil
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+const lookupRefCSV = `code,name,region
+13,東京都,関東
+27,大阪府,近畿
+13,重複,重複
+`
+
+func TestLookupWithoutKey(t *testing.T) {
+	r := bytes.NewBufferString("aaa\n13\n")
+	ref := bytes.NewBufferString(lookupRefCSV)
+	w := &bytes.Buffer{}
+	o := LookupOption{
+		ReferenceKey: "code",
+		TakeSyms:     []string{"name"},
+	}
+
+	if err := Lookup(r, w, ref, o); err == nil {
+		t.Fatal("Lookup without key should raise error.")
+	}
+}
+
+func TestLookupWithoutReferenceKey(t *testing.T) {
+	r := bytes.NewBufferString("aaa\n13\n")
+	ref := bytes.NewBufferString(lookupRefCSV)
+	w := &bytes.Buffer{}
+	o := LookupOption{
+		Key:      "aaa",
+		TakeSyms: []string{"name"},
+	}
+
+	if err := Lookup(r, w, ref, o); err == nil {
+		t.Fatal("Lookup without reference key should raise error.")
+	}
+}
+
+func TestLookupWithoutTakeColumn(t *testing.T) {
+	r := bytes.NewBufferString("aaa\n13\n")
+	ref := bytes.NewBufferString(lookupRefCSV)
+	w := &bytes.Buffer{}
+	o := LookupOption{
+		Key:          "aaa",
+		ReferenceKey: "code",
+	}
+
+	if err := Lookup(r, w, ref, o); err == nil {
+		t.Fatal("Lookup without taken column should raise error.")
+	}
+}
+
+func TestLookupWithNoHeaderButKeyNotNumber(t *testing.T) {
+	r := bytes.NewBufferString("13\n")
+	ref := bytes.NewBufferString(lookupRefCSV)
+	w := &bytes.Buffer{}
+	o := LookupOption{
+		NoHeader:     true,
+		Key:          "aaa",
+		ReferenceKey: "code",
+		TakeSyms:     []string{"name"},
+	}
+
+	if err := Lookup(r, w, ref, o); err == nil {
+		t.Fatal("Lookup with not number key for no header CSV should raise error.")
+	}
+}
+
+func TestLookupWithUnknownKey(t *testing.T) {
+	r := bytes.NewBufferString("aaa\n13\n")
+	ref := bytes.NewBufferString(lookupRefCSV)
+	w := &bytes.Buffer{}
+	o := LookupOption{
+		Key:          "bbb",
+		ReferenceKey: "code",
+		TakeSyms:     []string{"name"},
+	}
+
+	if err := Lookup(r, w, ref, o); err == nil {
+		t.Fatal("Lookup with unknown key should raise error.")
+	}
+}
+
+func TestLookupWithUnknownReferenceColumn(t *testing.T) {
+	r := bytes.NewBufferString("aaa\n13\n")
+	ref := bytes.NewBufferString(lookupRefCSV)
+	w := &bytes.Buffer{}
+	o := LookupOption{
+		Key:          "aaa",
+		ReferenceKey: "code",
+		TakeSyms:     []string{"name", "zip"},
+	}
+
+	if err := Lookup(r, w, ref, o); err == nil {
+		t.Fatal("Lookup with unknown reference column should raise error.")
+	}
+}
+
+func TestLookupWithBrokenReference(t *testing.T) {
+	r := bytes.NewBufferString("aaa\n13\n")
+	ref := bytes.NewBufferString("code,name\n13\n")
+	w := &bytes.Buffer{}
+	o := LookupOption{
+		Key:          "aaa",
+		ReferenceKey: "code",
+		TakeSyms:     []string{"name"},
+	}
+
+	if err := Lookup(r, w, ref, o); err == nil {
+		t.Fatal("Lookup with broken reference should raise error.")
+	}
+}
+
+func TestLookup(t *testing.T) {
+	s := `id,pref_code
+1,13
+2,27
+3,99
+`
+	r := bytes.NewBufferString(s)
+	ref := bytes.NewBufferString(lookupRefCSV)
+	w := &bytes.Buffer{}
+	o := LookupOption{
+		Key:          "pref_code",
+		ReferenceKey: "code",
+		TakeSyms:     []string{"name", "region"},
+	}
+
+	if err := Lookup(r, w, ref, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `id,pref_code,name,region
+1,13,東京都,関東
+2,27,大阪府,近畿
+3,99,,
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestLookupWithDefault(t *testing.T) {
+	s := `id,pref_code
+1,13
+3,99
+`
+	r := bytes.NewBufferString(s)
+	ref := bytes.NewBufferString(lookupRefCSV)
+	w := &bytes.Buffer{}
+	o := LookupOption{
+		Key:          "pref_code",
+		ReferenceKey: "0",
+		TakeSyms:     []string{"1"},
+		Default:      "不明",
+	}
+
+	if err := Lookup(r, w, ref, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `id,pref_code,name
+1,13,東京都
+3,99,不明
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestLookupWithFailOnMiss(t *testing.T) {
+	s := `id,pref_code
+1,13
+3,99
+`
+	r := bytes.NewBufferString(s)
+	ref := bytes.NewBufferString(lookupRefCSV)
+	w := &bytes.Buffer{}
+	o := LookupOption{
+		Key:          "pref_code",
+		ReferenceKey: "code",
+		TakeSyms:     []string{"name"},
+		FailOnMiss:   true,
+	}
+
+	if err := Lookup(r, w, ref, o); err == nil {
+		t.Fatal("Lookup with missed key on fail on miss mode should raise error.")
+	}
+}
+
+func TestLookupWithNoHeader(t *testing.T) {
+	s := `1,27
+`
+	r := bytes.NewBufferString(s)
+	ref := bytes.NewBufferString(lookupRefCSV)
+	w := &bytes.Buffer{}
+	o := LookupOption{
+		NoHeader:     true,
+		Key:          "1",
+		ReferenceKey: "code",
+		TakeSyms:     []string{"region"},
+	}
+
+	if err := Lookup(r, w, ref, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `1,27,近畿
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestLookupWithSJISReference(t *testing.T) {
+	p, err := ioutil.ReadFile("testdata/sjis.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := `id,fruit
+1,みかん
+`
+	r := bytes.NewBufferString(s)
+	ref := bytes.NewBuffer(p)
+	w := &bytes.Buffer{}
+	o := LookupOption{
+		Key:               "fruit",
+		ReferenceKey:      "名前",
+		TakeSyms:          []string{"個数"},
+		ReferenceEncoding: "sjis",
+	}
+
+	if err := Lookup(r, w, ref, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `id,fruit,個数
+1,みかん,2
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
@@ -0,0 +1,176 @@
+package csvutil
+
+import (
+	"io"
+	"math/rand"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// MyNumberOption is option holder for MyNumber.
+type MyNumberOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// Target column symbol.
+	Column string
+	// Use corporate number (13 digits) instead of individual number (12 digits).
+	Corporate bool
+	// Validate existing values instead of generating.
+	Validate bool
+	// Column symbol for validation result. (valid or invalid)
+	// If empty, validation stops with error at first invalid value.
+	ResultColumn string
+}
+
+func (o MyNumberOption) validate() error {
+	if o.Column == "" {
+		return errors.New("no column")
+	}
+	if o.NoHeader && (!isDigit(o.Column) || !isEmptyOrDigit(o.ResultColumn)) {
+		return errors.New("not number column symbol")
+	}
+	if !o.Validate && o.ResultColumn != "" {
+		return errors.New("result column is available only in validate mode")
+	}
+	return nil
+}
+
+func (o MyNumberOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+func (o MyNumberOption) fake() string {
+	if o.Corporate {
+		return fakeCorporateNumber()
+	}
+	return fakeIndividualNumber()
+}
+
+func (o MyNumberOption) isValid(s string) bool {
+	if o.Corporate {
+		return isValidCorporateNumber(s)
+	}
+	return isValidIndividualNumber(s)
+}
+
+// MyNumber overwrite value of given column by dummy individual number (My Number) or corporate number.
+// In validate mode, MyNumber checks existing values by check digit instead.
+func MyNumber(r io.Reader, w io.Writer, o MyNumberOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	var col, resCol *column
+	setup := func(hdr []string) error {
+		col = newColumnWithIndex(o.Column, hdr)
+		resCol = newColumnWithIndex(o.ResultColumn, hdr)
+		return columns{col, resCol}.err()
+	}
+	csvp := NewCSVProcessor(cr, cw)
+	if o.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			return setup(nil)
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			return hdr, setup(hdr)
+		})
+	}
+
+	line := 0
+	if !o.NoHeader {
+		line = 1
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		line++
+		if !o.Validate {
+			rec[col.index] = o.fake()
+			return rec, nil
+		}
+		v := rec[col.index]
+		if resCol.index != -1 {
+			if v == "" {
+				rec[resCol.index] = ""
+			} else if o.isValid(v) {
+				rec[resCol.index] = "valid"
+			} else {
+				rec[resCol.index] = "invalid"
+			}
+			return rec, nil
+		}
+		if v != "" && !o.isValid(v) {
+			return nil, errors.Errorf("invalid number at line %d: %s", line, v)
+		}
+		return rec, nil
+	})
+
+	return csvp.Process()
+}
+
+func fakeIndividualNumber() string {
+	s := fakeDigits(11)
+	return s + strconv.Itoa(individualNumberCheckDigit(s))
+}
+
+func fakeCorporateNumber() string {
+	// first digit of base number is not 0.
+	s := strconv.Itoa(rand.Intn(9)+1) + fakeDigits(11)
+	return strconv.Itoa(corporateNumberCheckDigit(s)) + s
+}
+
+// individualNumberCheckDigit returns check digit for 11 digits of individual number.
+func individualNumberCheckDigit(s string) int {
+	sum := 0
+	for n := 1; n <= 11; n++ {
+		p := int(s[len(s)-n] - '0')
+		q := n + 1
+		if n >= 7 {
+			q = n - 5
+		}
+		sum += p * q
+	}
+	r := sum % 11
+	if r <= 1 {
+		return 0
+	}
+	return 11 - r
+}
+
+// corporateNumberCheckDigit returns check digit for 12 digits of corporate number.
+func corporateNumberCheckDigit(s string) int {
+	sum := 0
+	for n := 1; n <= 12; n++ {
+		p := int(s[len(s)-n] - '0')
+		if n%2 == 0 {
+			p *= 2
+		}
+		sum += p
+	}
+	return 9 - sum%9
+}
+
+func isValidIndividualNumber(s string) bool {
+	if len(s) != 12 || !isDigit(s) {
+		return false
+	}
+	return individualNumberCheckDigit(s[:11]) == int(s[11]-'0')
+}
+
+func isValidCorporateNumber(s string) bool {
+	if len(s) != 13 || !isDigit(s) {
+		return false
+	}
+	return corporateNumberCheckDigit(s[1:]) == int(s[0]-'0')
+}
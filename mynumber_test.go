@@ -0,0 +1,182 @@
+package csvutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMyNumberWithoutColumn(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MyNumberOption{}
+
+	if err := MyNumber(r, w, o); err == nil {
+		t.Fatal("MyNumber without column symbol should raise error.")
+	}
+}
+
+func TestMyNumberWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := `1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MyNumberOption{
+		NoHeader: true,
+		Column:   "foo",
+	}
+
+	if err := MyNumber(r, w, o); err == nil {
+		t.Fatal("MyNumber with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestMyNumberWithResultColumnButNotValidate(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MyNumberOption{
+		Column:       "aaa",
+		ResultColumn: "bbb",
+	}
+
+	if err := MyNumber(r, w, o); err == nil {
+		t.Fatal("MyNumber with result column but not validate mode should raise error.")
+	}
+}
+
+func TestMyNumberWithColumnNotFound(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MyNumberOption{
+		Column: "ddd",
+	}
+
+	if err := MyNumber(r, w, o); err == nil {
+		t.Fatal("MyNumber with not found column should raise error.")
+	}
+}
+
+func TestMyNumber(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+7,8,9
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MyNumberOption{
+		Column: "bbb",
+	}
+
+	if err := MyNumber(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	if !allOK(recs, 1, isValidIndividualNumber) {
+		t.Errorf("Invalid individual number: %v", recs)
+	}
+}
+
+func TestMyNumberWithCorporate(t *testing.T) {
+	s := `1,2,3
+4,5,6
+7,8,9
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MyNumberOption{
+		NoHeader:  true,
+		Column:    "0",
+		Corporate: true,
+	}
+
+	if err := MyNumber(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	recs := readCSV(w.String())
+	if !allOKNoHeader(recs, 0, isValidCorporateNumber) {
+		t.Errorf("Invalid corporate number: %v", recs)
+	}
+}
+
+func TestMyNumberWithValidate(t *testing.T) {
+	s := `番号,結果
+123456789018,
+123456789012,
+,
+12345678901,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MyNumberOption{
+		Column:       "番号",
+		Validate:     true,
+		ResultColumn: "結果",
+	}
+
+	if err := MyNumber(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	expected := `番号,結果
+123456789018,valid
+123456789012,invalid
+,
+12345678901,invalid
+`
+	if a := w.String(); a != expected {
+		t.Errorf("Expected: %s, but got %s", expected, a)
+	}
+}
+
+func TestMyNumberWithValidateWithoutResultColumn(t *testing.T) {
+	s := `番号
+7000012050002
+1000012050002
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MyNumberOption{
+		Column:    "番号",
+		Corporate: true,
+		Validate:  true,
+	}
+
+	err := MyNumber(r, w, o)
+	if err == nil {
+		t.Fatal("MyNumber with invalid value in validate mode should raise error.")
+	}
+	if err.Error() != "invalid number at line 3: 1000012050002" {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func TestIsValidIndividualNumber(t *testing.T) {
+	if !isValidIndividualNumber("123456789018") {
+		t.Error("123456789018 should be valid")
+	}
+	for _, s := range []string{"123456789012", "12345678901a", "1234567890180"} {
+		if isValidIndividualNumber(s) {
+			t.Errorf("%s should be invalid", s)
+		}
+	}
+}
+
+func TestIsValidCorporateNumber(t *testing.T) {
+	if !isValidCorporateNumber("7000012050002") {
+		t.Error("7000012050002 should be valid")
+	}
+	for _, s := range []string{"1000012050002", "700001205000a", "700001205000"} {
+		if isValidCorporateNumber(s) {
+			t.Errorf("%s should be invalid", s)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 var supportedGenderFormats = []string{"code", "en_short", "en_long", "jp_short", "jp_long", "symbol"}
 var maleGenders = []string{"1", "M", "Male", "男", "男性", "♂"}
 var femaleGenders = []string{"2", "F", "Female", "女", "女性", "♀"}
+var supportedRomajiCases = []string{"upper", "title"}
 
 // NameOption is option holder for Name.
 type NameOption struct {
@@ -36,6 +37,16 @@ type NameOption struct {
 	LastKana string
 	// Output hiragana as kana
 	Hiragana bool
+	// Romaji of full name column symbol
+	Romaji string
+	// Romaji of first name column symbol
+	FirstRomaji string
+	// Romaji of last name column symbol
+	LastRomaji string
+	// Romaji case (upper or title, default title)
+	RomajiCase string
+	// Output romaji of full name in order of last name and first name
+	RomajiLastNameFirst bool
 	// Gender column symbol
 	Gender string
 	// Gender format
@@ -69,13 +80,20 @@ func (o NameOption) hasTargetColumn() bool {
 	if o.LastKana != "" {
 		return true
 	}
+	if o.hasRomajiColumn() {
+		return true
+	}
 	if o.Gender != "" {
 		return true
 	}
 	return false
 }
 
-func (o NameOption) validate() error {
+func (o NameOption) hasRomajiColumn() bool {
+	return o.Romaji != "" || o.FirstRomaji != "" || o.LastRomaji != ""
+}
+
+func (o *NameOption) validate() error {
 	if !o.hasTargetColumn() {
 		return errors.New("no column")
 	}
@@ -98,6 +116,15 @@ func (o NameOption) validate() error {
 		if !isEmptyOrDigit(o.LastKana) {
 			return errors.New("not number last kana column symbol")
 		}
+		if !isEmptyOrDigit(o.Romaji) {
+			return errors.New("not number romaji column symbol")
+		}
+		if !isEmptyOrDigit(o.FirstRomaji) {
+			return errors.New("not number first romaji column symbol")
+		}
+		if !isEmptyOrDigit(o.LastRomaji) {
+			return errors.New("not number last romaji column symbol")
+		}
 		if !isEmptyOrDigit(o.Gender) {
 			return errors.New("not number gender column symbol")
 		}
@@ -111,6 +138,12 @@ func (o NameOption) validate() error {
 	if o.Gender != "" && !containsString(supportedGenderFormats, o.GenderFormat) {
 		return errors.Errorf("unsupported gender format: %s", o.GenderFormat)
 	}
+	if o.RomajiCase == "" {
+		o.RomajiCase = "title"
+	}
+	if o.hasRomajiColumn() && !containsString(supportedRomajiCases, o.RomajiCase) {
+		return errors.Errorf("unsupported romaji case: %s", o.RomajiCase)
+	}
 
 	return nil
 }
@@ -125,6 +158,19 @@ func (o NameOption) space() string {
 	return sp
 }
 
+func (o NameOption) romaji(hiragana string) string {
+	return romajiCase(toRomaji(hiragana), o.RomajiCase)
+}
+
+func (o NameOption) fullNameRomaji(name *gimei.Name) string {
+	first := o.romaji(name.First.Hiragana())
+	last := o.romaji(name.Last.Hiragana())
+	if o.RomajiLastNameFirst {
+		return last + " " + first
+	}
+	return first + " " + last
+}
+
 func (o NameOption) outputEncoding() string {
 	if o.OutputEncoding != "" {
 		return o.OutputEncoding
@@ -133,14 +179,17 @@ func (o NameOption) outputEncoding() string {
 }
 
 type nameCols struct {
-	name      *column
-	firstName *column
-	lastName  *column
-	kana      *column
-	firstKana *column
-	lastKana  *column
-	gender    *column
-	reference *column
+	name        *column
+	firstName   *column
+	lastName    *column
+	kana        *column
+	firstKana   *column
+	lastKana    *column
+	romaji      *column
+	firstRomaji *column
+	lastRomaji  *column
+	gender      *column
+	reference   *column
 }
 
 func (c *nameCols) err() error {
@@ -151,6 +200,9 @@ func (c *nameCols) err() error {
 		c.kana,
 		c.firstKana,
 		c.lastKana,
+		c.romaji,
+		c.firstRomaji,
+		c.lastRomaji,
 		c.gender,
 		c.reference,
 	}
@@ -170,6 +222,9 @@ func (c *nameCols) indexes() []int {
 		c.kana.index,
 		c.firstKana.index,
 		c.lastKana.index,
+		c.romaji.index,
+		c.firstRomaji.index,
+		c.lastRomaji.index,
 		c.gender.index,
 	}
 }
@@ -239,6 +294,12 @@ func Name(r io.Reader, w io.Writer, o NameOption) error {
 				} else {
 					newRec[i] = name.Last.Katakana()
 				}
+			} else if i == cols.romaji.index {
+				newRec[i] = o.fullNameRomaji(name)
+			} else if i == cols.firstRomaji.index {
+				newRec[i] = o.romaji(name.First.Hiragana())
+			} else if i == cols.lastRomaji.index {
+				newRec[i] = o.romaji(name.Last.Hiragana())
 			} else if i == cols.gender.index {
 				genders := maleGenders
 				if name.IsFemale() {
@@ -265,6 +326,9 @@ func setupNameCols(o NameOption, hdr []string) *nameCols {
 	cols.kana = newColumnWithIndex(o.Kana, hdr)
 	cols.firstKana = newColumnWithIndex(o.FirstKana, hdr)
 	cols.lastKana = newColumnWithIndex(o.LastKana, hdr)
+	cols.romaji = newColumnWithIndex(o.Romaji, hdr)
+	cols.firstRomaji = newColumnWithIndex(o.FirstRomaji, hdr)
+	cols.lastRomaji = newColumnWithIndex(o.LastRomaji, hdr)
 	cols.gender = newColumnWithIndex(o.Gender, hdr)
 	cols.reference = newColumnWithIndex(o.Reference, hdr)
 	return cols
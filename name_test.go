@@ -1192,3 +1192,132 @@ func isKatakanaOrSpace(s string) bool {
 func isMultibyte(s string) bool {
 	return len(s) != len([]rune(s))
 }
+
+func TestNameWithNoHeaderAndNotDigitRomaji(t *testing.T) {
+	s := `1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NameOption{
+		NoHeader:   true,
+		Romaji:     "aaa",
+		RomajiCase: "title",
+	}
+
+	if err := Name(r, w, o); err == nil {
+		t.Fatal("Name with no header and not digit romaji should raise error.")
+	}
+}
+
+func TestNameWithUnsupportedRomajiCase(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NameOption{
+		FirstRomaji: "aaa",
+		RomajiCase:  "foo",
+	}
+
+	if err := Name(r, w, o); err == nil {
+		t.Fatal("Name with unsupported romaji case should raise error.")
+	}
+}
+
+func TestNameWithRomaji(t *testing.T) {
+	s := `姓,名,姓かな,名かな,LAST_NAME_EN,FIRST_NAME_EN,NAME_EN
+1,2,3,4,5,6,7
+1,2,3,4,5,6,7
+1,2,3,4,5,6,7
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NameOption{
+		LastName:    "姓",
+		FirstName:   "名",
+		LastKana:    "姓かな",
+		FirstKana:   "名かな",
+		Hiragana:    true,
+		LastRomaji:  "LAST_NAME_EN",
+		FirstRomaji: "FIRST_NAME_EN",
+		Romaji:      "NAME_EN",
+		RomajiCase:  "upper",
+	}
+
+	if err := Name(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	actual := readCSV(w.String())
+	for _, rec := range actual[1:] {
+		last := strings.ToUpper(toRomaji(rec[2]))
+		first := strings.ToUpper(toRomaji(rec[3]))
+		if rec[4] != last || rec[5] != first {
+			t.Errorf("Romaji should be derived from kana: %v", rec)
+		}
+		if rec[6] != first+" "+last {
+			t.Errorf("Invalid full name romaji: %v", rec)
+		}
+	}
+}
+
+func TestNameWithRomajiTitleAndLastNameFirst(t *testing.T) {
+	s := `1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NameOption{
+		NoHeader:            true,
+		LastKana:            "0",
+		Hiragana:            true,
+		Romaji:              "1",
+		RomajiCase:          "title",
+		RomajiLastNameFirst: true,
+	}
+
+	if err := Name(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	actual := readCSV(w.String())
+	for _, rec := range actual {
+		last := romajiCase(toRomaji(rec[0]), "title")
+		if !strings.HasPrefix(rec[1], last+" ") {
+			t.Errorf("Romaji should start with last name %s: %s", last, rec[1])
+		}
+		for _, n := range strings.Split(rec[1], " ") {
+			if !unicode.IsUpper([]rune(n)[0]) || strings.ToLower(n[1:]) != n[1:] {
+				t.Errorf("Romaji should be title case: %s", rec[1])
+			}
+		}
+	}
+}
+
+func TestNameWithRomajiWithoutRomajiCase(t *testing.T) {
+	s := `1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NameOption{
+		NoHeader:  true,
+		FirstKana: "0",
+		Hiragana:  true,
+		Romaji:    "1",
+	}
+
+	if err := Name(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	actual := readCSV(w.String())
+	for _, rec := range actual {
+		first := romajiCase(toRomaji(rec[0]), "title")
+		if !strings.HasPrefix(rec[1], first+" ") {
+			t.Errorf("Romaji should be title case by default: %s", rec[1])
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package csvutil
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/unicode/norm"
+)
+
+var supportedWidths = []string{"zenkaku", "hankaku"}
+
+// hyphens are hyphen like characters normalized to ASCII hyphen-minus.
+var hyphens = []rune{'‐', '‑', '‒', '–', '—', '―', '−', '－', '﹣', '⁃'}
+
+// prolongedSoundMarks are replaced to hyphen only when they follow an alphanumeric character.
+var prolongedSoundMarks = []rune{'ー', 'ｰ'}
+
+// halfWidthKanaMap maps full width katakana (and symbols) to half width katakana.
+// It is built from Unicode compatibility mapping of half width katakana block.
+var halfWidthKanaMap = buildHalfWidthKanaMap()
+
+func buildHalfWidthKanaMap() map[rune]string {
+	m := map[rune]string{
+		'゛': "ﾞ",
+		'゜': "ﾟ",
+	}
+	for r := rune(0xFF61); r <= 0xFF9F; r++ {
+		hw := string(r)
+		m[[]rune(norm.NFKC.String(hw))[0]] = hw
+		for _, mark := range []string{"ﾞ", "ﾟ"} {
+			fw := []rune(norm.NFKC.String(hw + mark))
+			if len(fw) == 1 {
+				m[fw[0]] = hw + mark
+			}
+		}
+	}
+	return m
+}
+
+// NormalizeOption is option holder for Normalize.
+type NormalizeOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// ColumnSyms header or column index list. (default all columns)
+	ColumnSyms []string
+	// Apply Unicode NFKC normalization.
+	NFKC bool
+	// Width of katakana. (zenkaku or hankaku)
+	Kana string
+	// Convert hiragana to katakana.
+	HiraganaToKatakana bool
+	// Convert katakana to hiragana.
+	KatakanaToHiragana bool
+	// Width of digits. (zenkaku or hankaku)
+	Digits string
+	// Width of alphabets. (zenkaku or hankaku)
+	Alphabets string
+	// Unify hyphen like characters into hyphen-minus.
+	Hyphen bool
+}
+
+func (o NormalizeOption) validate() error {
+	if o.NoHeader {
+		for _, c := range o.ColumnSyms {
+			if !isDigit(c) {
+				return errors.New("not number column symbol")
+			}
+		}
+	}
+	for _, w := range []string{o.Kana, o.Digits, o.Alphabets} {
+		if w != "" && !containsString(supportedWidths, w) {
+			return errors.Errorf("unsupported width: %s", w)
+		}
+	}
+	if o.HiraganaToKatakana && o.KatakanaToHiragana {
+		return errors.New("hiragana to katakana and katakana to hiragana cannot be used together")
+	}
+	if !o.NFKC && o.Kana == "" && !o.HiraganaToKatakana && !o.KatakanaToHiragana && o.Digits == "" && o.Alphabets == "" && !o.Hyphen {
+		return errors.New("no normalization")
+	}
+	return nil
+}
+
+func (o NormalizeOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Normalize character width and kana of given columns.
+func Normalize(r io.Reader, w io.Writer, o NormalizeOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	var cols columns
+	csvp := NewCSVProcessor(cr, cw)
+	if o.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			cols = newUniqueColumns(o.ColumnSyms, nil)
+			return cols.err()
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			cols = newUniqueColumns(o.ColumnSyms, hdr)
+			return hdr, cols.err()
+		})
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		for i := range rec {
+			if len(cols) == 0 || containsColumnIndex(cols, i) {
+				rec[i] = o.normalize(rec[i])
+			}
+		}
+		return rec, nil
+	})
+
+	return csvp.Process()
+}
+
+func (o NormalizeOption) normalize(s string) string {
+	if o.NFKC {
+		s = norm.NFKC.String(s)
+	}
+	if o.HiraganaToKatakana {
+		s = strings.Map(hiraganaToKatakana, s)
+	} else if o.KatakanaToHiragana {
+		s = strings.Map(katakanaToHiragana, s)
+	}
+	if o.Kana == "zenkaku" {
+		s = toFullWidthKana(s)
+	} else if o.Kana == "hankaku" {
+		s = toHalfWidthKana(s)
+	}
+	if o.Digits != "" {
+		s = strings.Map(widthMapper(o.Digits, unicode.IsDigit), s)
+	}
+	if o.Alphabets != "" {
+		s = strings.Map(widthMapper(o.Alphabets, unicode.IsLetter), s)
+	}
+	if o.Hyphen {
+		s = unifyHyphens(s)
+	}
+	return s
+}
+
+// widthMapper returns mapper that converts width of ASCII compatible runes that satisfy f.
+func widthMapper(width string, f func(rune) bool) func(rune) rune {
+	return func(r rune) rune {
+		if width == "zenkaku" && '!' <= r && r <= '~' && f(r) {
+			return r + 0xFEE0
+		}
+		if width == "hankaku" && '！' <= r && r <= '～' && f(r) {
+			return r - 0xFEE0
+		}
+		return r
+	}
+}
+
+func toFullWidthKana(s string) string {
+	b := &bytes.Buffer{}
+	rs := []rune(s)
+	for i := 0; i < len(rs); i++ {
+		if !isHalfWidthKana(rs[i]) {
+			b.WriteRune(rs[i])
+			continue
+		}
+		j := i + 1
+		for j < len(rs) && isHalfWidthKana(rs[j]) {
+			j++
+		}
+		b.WriteString(norm.NFKC.String(string(rs[i:j])))
+		i = j - 1
+	}
+	return b.String()
+}
+
+func toHalfWidthKana(s string) string {
+	b := &bytes.Buffer{}
+	for _, r := range norm.NFC.String(s) {
+		if hw, ok := halfWidthKanaMap[r]; ok {
+			b.WriteString(hw)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func isHalfWidthKana(r rune) bool {
+	return 0xFF61 <= r && r <= 0xFF9F
+}
+
+func hiraganaToKatakana(r rune) rune {
+	if ('ぁ' <= r && r <= 'ゖ') || r == 'ゝ' || r == 'ゞ' {
+		return r + 0x60
+	}
+	return r
+}
+
+func katakanaToHiragana(r rune) rune {
+	if ('ァ' <= r && r <= 'ヶ') || r == 'ヽ' || r == 'ヾ' {
+		return r - 0x60
+	}
+	return r
+}
+
+func unifyHyphens(s string) string {
+	rs := []rune(s)
+	for i, r := range rs {
+		if containsRune(hyphens, r) {
+			rs[i] = '-'
+		} else if containsRune(prolongedSoundMarks, r) && i > 0 && isAlphanumeric(rs[i-1]) {
+			rs[i] = '-'
+		}
+	}
+	return string(rs)
+}
+
+func isAlphanumeric(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) ||
+		('０' <= r && r <= '９') || ('Ａ' <= r && r <= 'Ｚ') || ('ａ' <= r && r <= 'ｚ')
+}
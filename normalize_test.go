@@ -0,0 +1,155 @@
+package csvutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNormalizeWithoutNormalization(t *testing.T) {
+	s := `aaa,bbb
+1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NormalizeOption{}
+
+	if err := Normalize(r, w, o); err == nil {
+		t.Fatal("Normalize without normalization should raise error.")
+	}
+}
+
+func TestNormalizeWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := `1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NormalizeOption{
+		NoHeader:   true,
+		ColumnSyms: []string{"aaa"},
+		NFKC:       true,
+	}
+
+	if err := Normalize(r, w, o); err == nil {
+		t.Fatal("Normalize with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestNormalizeWithUnsupportedWidth(t *testing.T) {
+	s := `aaa,bbb
+1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NormalizeOption{
+		Digits: "half",
+	}
+
+	if err := Normalize(r, w, o); err == nil {
+		t.Fatal("Normalize with unsupported width should raise error.")
+	}
+}
+
+func TestNormalizeWithBothKanaConversions(t *testing.T) {
+	s := `aaa,bbb
+1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NormalizeOption{
+		HiraganaToKatakana: true,
+		KatakanaToHiragana: true,
+	}
+
+	if err := Normalize(r, w, o); err == nil {
+		t.Fatal("Normalize with both kana conversions should raise error.")
+	}
+}
+
+func TestNormalizeWithUnknownColumn(t *testing.T) {
+	s := `aaa,bbb
+1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NormalizeOption{
+		ColumnSyms: []string{"ccc"},
+		NFKC:       true,
+	}
+
+	if err := Normalize(r, w, o); err == nil {
+		t.Fatal("Normalize with unknown column should raise error.")
+	}
+}
+
+func TestNormalizeWithColumns(t *testing.T) {
+	s := `aaa,bbb
+ｱｲｳ１２３,ｱｲｳ１２３
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NormalizeOption{
+		ColumnSyms: []string{"bbb"},
+		NFKC:       true,
+	}
+
+	if err := Normalize(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb
+ｱｲｳ１２３,アイウ123
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestNormalizeWithAllColumns(t *testing.T) {
+	s := `ａａａ,ｂｂｂ
+ｶﾞｷﾞ,ﾊﾟﾋﾟ
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NormalizeOption{
+		Kana: "zenkaku",
+	}
+
+	if err := Normalize(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `ａａａ,ｂｂｂ
+ガギ,パピ
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestNormalizeValue(t *testing.T) {
+	tests := []struct {
+		opt      NormalizeOption
+		src      string
+		expected string
+	}{
+		{NormalizeOption{NFKC: true}, "ﾃﾞｰﾀ１２３ＡＢＣ㈱", "データ123ABC(株)"},
+		{NormalizeOption{Kana: "zenkaku"}, "ｳﾞｧｲｵﾘﾝ｡ABC１", "ヴァイオリン。ABC１"},
+		{NormalizeOption{Kana: "hankaku"}, "ヴァイオリン・パン。ABC", "ｳﾞｧｲｵﾘﾝ･ﾊﾟﾝ｡ABC"},
+		{NormalizeOption{Kana: "hankaku"}, "ひらがなガ", "ひらがなｶﾞ"},
+		{NormalizeOption{HiraganaToKatakana: true}, "やまだ たろう ゞ", "ヤマダ タロウ ヾ"},
+		{NormalizeOption{KatakanaToHiragana: true}, "ヤマダ タロウ ヴ", "やまだ たろう ゔ"},
+		{NormalizeOption{Digits: "hankaku"}, "０１２ＡＢＣ", "012ＡＢＣ"},
+		{NormalizeOption{Digits: "zenkaku"}, "012ABC", "０１２ABC"},
+		{NormalizeOption{Alphabets: "hankaku"}, "０１２ＡＢＣｘｙｚ", "０１２ABCxyz"},
+		{NormalizeOption{Alphabets: "zenkaku"}, "012ABCxyz", "012ＡＢＣｘｙｚ"},
+		{NormalizeOption{Hyphen: true}, "03－1234‐5678−9ー0", "03-1234-5678-9-0"},
+		{NormalizeOption{Hyphen: true}, "データー", "データー"},
+		{NormalizeOption{Hyphen: true}, "Aｰ1", "A-1"},
+		{NormalizeOption{NFKC: true, Kana: "hankaku", Hyphen: true}, "ﾃﾞｰﾀ０３ー１", "ﾃﾞｰﾀ03-1"},
+	}
+	for _, tt := range tests {
+		if actual := tt.opt.normalize(tt.src); actual != tt.expected {
+			t.Errorf("Expected: %s, but got %s (%+v)", tt.expected, actual, tt.opt)
+		}
+	}
+}
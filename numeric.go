@@ -6,10 +6,13 @@ import (
 	"math"
 	"math/rand"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 )
 
+var supportedDistributions = []string{"uniform", "normal", "lognormal", "exponential", "poisson"}
+
 // NumericOption is option holder for Numeric.
 type NumericOption struct {
 	// Source file does not have header line. (default false)
@@ -21,16 +24,46 @@ type NumericOption struct {
 	// Target column symbol.
 	Column string
 	// Max value
+	//
+	// Deprecated: Use MaxFloat instead.
 	Max int
 	// Min value
+	//
+	// Deprecated: Use MinFloat instead.
 	Min int
+	// Max value (exclusive unless InclusiveMax)
+	MaxFloat float64
+	// Min value
+	MinFloat float64
+	// Include max value.
+	InclusiveMax bool
 	// Output decimal instead of integer
 	Decimal bool
 	// Digit of decimal
 	DecimalDigit int
+	// Distribution of numbers. (uniform, normal, lognormal, exponential or poisson, default uniform)
+	// Except uniform, numbers out of range between min and max are drawn again.
+	Distribution string
+	// Mean of normal, exponential and poisson. On lognormal, mean of logarithm.
+	Mean float64
+	// Standard deviation of normal. On lognormal, standard deviation of logarithm.
+	StdDev float64
+	// Round number to multiple of step.
+	Step float64
+	// Insert thousands separators.
+	ThousandsSeparator bool
+	// Prefix of number. (e.g. ¥, $)
+	Prefix string
+	// Suffix of number. (e.g. 円)
+	Suffix string
+	// Rate of empty value.
+	EmptyRate int
+
+	minUnit int64
+	maxUnit int64
 }
 
-func (o NumericOption) validate() error {
+func (o *NumericOption) validate() error {
 	if o.Column == "" {
 		return errors.New("no column")
 	}
@@ -40,12 +73,44 @@ func (o NumericOption) validate() error {
 
 		}
 	}
-	if o.Max <= o.Min {
+	if o.MaxFloat == 0 && o.MinFloat == 0 {
+		o.MaxFloat, o.MinFloat = float64(o.Max), float64(o.Min)
+	}
+	if o.MaxFloat < o.MinFloat || (o.MaxFloat == o.MinFloat && !o.InclusiveMax) {
 		return errors.New("max should be greater than min")
 	}
 	if o.Decimal && o.DecimalDigit <= 0 {
 		return errors.New("decimal digit is not positive")
 	}
+	if o.Distribution == "" {
+		o.Distribution = "uniform"
+	}
+	if !containsString(supportedDistributions, o.Distribution) {
+		return errors.Errorf("unsupported distribution: %s", o.Distribution)
+	}
+	switch o.Distribution {
+	case "normal", "lognormal":
+		if o.StdDev <= 0 {
+			return errors.New("standard deviation is not positive")
+		}
+	case "exponential", "poisson":
+		if o.Mean <= 0 {
+			return errors.New("mean is not positive")
+		}
+	}
+	if o.Step < 0 {
+		return errors.New("step is negative")
+	}
+	if o.Step > 0 && o.Step*o.coefficient() < 1 {
+		return errors.New("step is less than decimal digit")
+	}
+	if o.EmptyRate < 0 || 100 < o.EmptyRate {
+		return errors.New("invalid empty rate")
+	}
+	o.minUnit, o.maxUnit = unitRange(*o)
+	if o.maxUnit <= o.minUnit {
+		return errors.New("no number in range between min and max for decimal digit")
+	}
 	return nil
 }
 
@@ -56,31 +121,43 @@ func (o NumericOption) outputEncoding() string {
 	return o.Encoding
 }
 
+func (o NumericOption) digit() int {
+	if o.Decimal {
+		return o.DecimalDigit
+	}
+	return 0
+}
+
+func (o NumericOption) coefficient() float64 {
+	return math.Pow10(o.digit())
+}
+
 // Numeric overwrite value of given column by random numbers.
 func Numeric(r io.Reader, w io.Writer, o NumericOption) error {
-	if err := o.validate(); err != nil {
+	opt := &o
+	if err := opt.validate(); err != nil {
 		return errors.Wrap(err, "invalid option")
 	}
 
-	cr, bom := reader(r, o.Encoding)
-	cw := writer(w, bom, o.outputEncoding())
+	cr, bom := reader(r, opt.Encoding)
+	cw := writer(w, bom, opt.outputEncoding())
 	defer cw.Flush()
 
 	var col *column
 	csvp := NewCSVProcessor(cr, cw)
-	if o.NoHeader {
+	if opt.NoHeader {
 		csvp.SetPreBodyRead(func() error {
-			col = newColumnWithIndex(o.Column, nil)
+			col = newColumnWithIndex(opt.Column, nil)
 			return col.err
 		})
 	} else {
 		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
-			col = newColumnWithIndex(o.Column, hdr)
+			col = newColumnWithIndex(opt.Column, hdr)
 			return hdr, col.err
 		})
 	}
 	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
-		rec[col.index] = fakeNumeric(o)
+		rec[col.index] = fakeNumeric(*opt)
 		return rec, nil
 	})
 
@@ -88,32 +165,141 @@ func Numeric(r io.Reader, w io.Writer, o NumericOption) error {
 }
 
 func fakeNumeric(o NumericOption) string {
-	if o.Decimal {
-		return fakeDecimal(o)
+	if lot(o.EmptyRate) {
+		return ""
+	}
+	return formatNumeric(o, fakeUnits(o))
+}
+
+// unitRange returns range of numbers in units of decimal digit.
+// min is inclusive and max is exclusive.
+func unitRange(o NumericOption) (int64, int64) {
+	c := o.coefficient()
+	min := int64(math.Ceil(o.MinFloat*c - 1e-9))
+	max := int64(math.Ceil(o.MaxFloat*c - 1e-9))
+	if o.InclusiveMax {
+		max = int64(math.Floor(o.MaxFloat*c+1e-9)) + 1
+	}
+	return min, max
+}
+
+// fakeUnits returns random number in units of decimal digit.
+func fakeUnits(o NumericOption) int64 {
+	min, max := o.minUnit, o.maxUnit
+	var n int64
+	if o.Distribution == "uniform" {
+		n = rand.Int63n(max-min) + min
+	} else {
+		n = sampleDistributionUnits(o)
+	}
+	if o.Step > 0 {
+		st := int64(round(o.Step * o.coefficient()))
+		n = int64(round(float64(n)/float64(st))) * st
+		for n < min {
+			n += st
+		}
+		for n >= max && n-st >= min {
+			n -= st
+		}
+	}
+	return n
+}
+
+// sampleDistributionUnits returns random number in units of decimal digit by distribution.
+// Numbers out of range are drawn again, and clamped if not in range after 100 times.
+func sampleDistributionUnits(o NumericOption) int64 {
+	var n int64
+	for i := 0; i < 100; i++ {
+		n = int64(round(sampleDistribution(o) * o.coefficient()))
+		if o.minUnit <= n && n < o.maxUnit {
+			return n
+		}
+	}
+	if n < o.minUnit {
+		return o.minUnit
 	}
-	return fakeInteger(o)
+	return o.maxUnit - 1
 }
 
-func fakeDecimal(o NumericOption) string {
-	coefficient := int(math.Pow10(o.DecimalDigit))
-	lim := (o.Max - o.Min) * coefficient
-	n := rand.Intn(lim) + (o.Min * coefficient)
+// sampleDistribution returns random number by distribution.
+func sampleDistribution(o NumericOption) float64 {
+	switch o.Distribution {
+	case "normal":
+		return rand.NormFloat64()*o.StdDev + o.Mean
+	case "lognormal":
+		return math.Exp(rand.NormFloat64()*o.StdDev + o.Mean)
+	case "exponential":
+		return rand.ExpFloat64() * o.Mean
+	case "poisson":
+		return float64(poisson(o.Mean))
+	}
+	return 0
+}
+
+// poisson returns random number by Poisson distribution.
+// Normal approximation is used for large lambda.
+func poisson(lambda float64) int {
+	if lambda > 30 {
+		n := int(round(rand.NormFloat64()*math.Sqrt(lambda) + lambda))
+		if n < 0 {
+			return 0
+		}
+		return n
+	}
+	l := math.Exp(-lambda)
+	k := 0
+	p := 1.0
+	for {
+		p *= rand.Float64()
+		if p <= l {
+			return k
+		}
+		k++
+	}
+}
+
+func round(f float64) float64 {
+	if f < 0 {
+		return -math.Floor(-f + 0.5)
+	}
+	return math.Floor(f + 0.5)
+}
+
+// formatNumeric formats number in units of decimal digit.
+func formatNumeric(o NumericOption, n int64) string {
 	nega := false
 	if n < 0 {
 		nega = true
 		n = n * -1
 	}
-	s := fmt.Sprintf("%0"+strconv.Itoa((o.DecimalDigit+1))+"d", n)
-	l := len(s)
-	s = s[:l-o.DecimalDigit] + "." + s[l-o.DecimalDigit:]
+	d := o.digit()
+	s := fmt.Sprintf("%0"+strconv.Itoa(d+1)+"d", n)
+	ip := s[:len(s)-d]
+	if o.ThousandsSeparator {
+		ip = insertThousandsSeparator(ip)
+	}
+	if d > 0 {
+		s = ip + "." + s[len(s)-d:]
+	} else {
+		s = ip
+	}
 	if nega {
-		return "-" + s
+		s = "-" + s
 	}
-	return s
+	return o.Prefix + s + o.Suffix
 }
 
-func fakeInteger(o NumericOption) string {
-	lim := o.Max - o.Min
-	n := rand.Intn(lim) + o.Min
-	return strconv.Itoa(n)
+func insertThousandsSeparator(s string) string {
+	if len(s) <= 3 {
+		return s
+	}
+	parts := make([]string, 0, len(s)/3+1)
+	head := len(s) % 3
+	if head > 0 {
+		parts = append(parts, s[:head])
+	}
+	for i := head; i < len(s); i += 3 {
+		parts = append(parts, s[i:i+3])
+	}
+	return strings.Join(parts, ",")
 }
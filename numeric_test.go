@@ -462,3 +462,249 @@ func TestNumericWithNegativeDecimalGreaterThanMinusOne(t *testing.T) {
 		t.Fatalf("Numeric failed updating with negative decimal. %+v", data)
 	}
 }
+
+func TestNumericWithInvalidDistribution(t *testing.T) {
+	tests := []NumericOption{
+		{Column: "aaa", MaxFloat: 100, Distribution: "gamma"},
+		{Column: "aaa", MaxFloat: 100, Distribution: "normal", Mean: 50},
+		{Column: "aaa", MaxFloat: 100, Distribution: "lognormal", Mean: 3},
+		{Column: "aaa", MaxFloat: 100, Distribution: "exponential"},
+		{Column: "aaa", MaxFloat: 100, Distribution: "poisson", Mean: -1},
+	}
+	for _, o := range tests {
+		s := `aaa,bbb
+1,2
+`
+		r := bytes.NewBufferString(s)
+		w := &bytes.Buffer{}
+		if err := Numeric(r, w, o); err == nil {
+			t.Errorf("Numeric with invalid distribution option should raise error. %+v", o)
+		}
+	}
+}
+
+func TestNumericWithInvalidStep(t *testing.T) {
+	tests := []NumericOption{
+		{Column: "aaa", MaxFloat: 100, Step: -1},
+		{Column: "aaa", MaxFloat: 100, Step: 0.5},
+		{Column: "aaa", MaxFloat: 100, Step: 0.001, Decimal: true, DecimalDigit: 2},
+	}
+	for _, o := range tests {
+		s := `aaa,bbb
+1,2
+`
+		r := bytes.NewBufferString(s)
+		w := &bytes.Buffer{}
+		if err := Numeric(r, w, o); err == nil {
+			t.Errorf("Numeric with invalid step should raise error. %+v", o)
+		}
+	}
+}
+
+func TestNumericWithInvalidEmptyRate(t *testing.T) {
+	s := `aaa,bbb
+1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NumericOption{
+		Column:    "aaa",
+		MaxFloat:  100,
+		EmptyRate: 101,
+	}
+
+	if err := Numeric(r, w, o); err == nil {
+		t.Fatal("Numeric with invalid empty rate should raise error.")
+	}
+}
+
+func TestNumericWithSameMinAndMax(t *testing.T) {
+	s := `aaa,bbb
+1,2
+1,2
+1,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NumericOption{
+		Column:   "aaa",
+		MaxFloat: 5,
+		MinFloat: 5,
+	}
+
+	if err := Numeric(r, w, o); err == nil {
+		t.Fatal("Numeric with same min and max should raise error.")
+	}
+
+	r = bytes.NewBufferString(s)
+	o.InclusiveMax = true
+	if err := Numeric(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range readCSV(w.String())[1:] {
+		if rec[0] != "5" {
+			t.Fatalf("Expected 5, but got %s", rec[0])
+		}
+	}
+}
+
+func TestNumericWithEmptyUnitRange(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := NumericOption{
+		Column:   "aaa",
+		MinFloat: 0.2,
+		MaxFloat: 0.8,
+	}
+
+	if err := Numeric(r, w, o); err == nil {
+		t.Fatal("Numeric without integer in range should raise error.")
+	}
+}
+
+func TestNumericWithFloatRange(t *testing.T) {
+	r := bytes.NewBufferString(generateCSV("aaa,bbb", 100, func(int) string { return "1,2" }))
+	w := &bytes.Buffer{}
+	o := NumericOption{
+		Column:       "aaa",
+		MinFloat:     0.5,
+		MaxFloat:     0.75,
+		InclusiveMax: true,
+		Decimal:      true,
+		DecimalDigit: 2,
+	}
+
+	if err := Numeric(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rec := range readCSV(w.String())[1:] {
+		f, err := strconv.ParseFloat(rec[0], 64)
+		if err != nil || f < 0.5 || 0.75 < f {
+			t.Fatalf("%s is out of range", rec[0])
+		}
+	}
+}
+
+func TestNumericWithDistributions(t *testing.T) {
+	tests := []NumericOption{
+		{Column: "aaa", MinFloat: 0, MaxFloat: 100, Distribution: "normal", Mean: 50, StdDev: 10},
+		{Column: "aaa", MinFloat: 0, MaxFloat: 100000, Distribution: "lognormal", Mean: 8, StdDev: 1},
+		{Column: "aaa", MinFloat: 0, MaxFloat: 100, Distribution: "exponential", Mean: 10},
+		{Column: "aaa", MinFloat: 0, MaxFloat: 100, Distribution: "poisson", Mean: 3},
+		{Column: "aaa", MinFloat: 0, MaxFloat: 100, Distribution: "poisson", Mean: 50},
+	}
+	for _, o := range tests {
+		r := bytes.NewBufferString(generateCSV("aaa,bbb", 500, func(int) string { return "1,2" }))
+		w := &bytes.Buffer{}
+		if err := Numeric(r, w, o); err != nil {
+			t.Fatal(err)
+		}
+
+		sum := 0
+		data := readCSV(w.String())[1:]
+		for _, rec := range data {
+			i, err := strconv.Atoi(rec[0])
+			if err != nil || i < int(o.MinFloat) || int(o.MaxFloat) <= i {
+				t.Fatalf("%s is out of range (%s)", rec[0], o.Distribution)
+			}
+			sum += i
+		}
+		if o.Distribution == "lognormal" {
+			continue
+		}
+		if avg := float64(sum) / float64(len(data)); avg < o.Mean*0.7 || o.Mean*1.3 < avg {
+			t.Errorf("Average of %s should be about %f, but got %f", o.Distribution, o.Mean, avg)
+		}
+	}
+}
+
+func TestNumericWithDistributionNearMax(t *testing.T) {
+	r := bytes.NewBufferString(generateCSV("aaa,bbb", 2000, func(int) string { return "1,2" }))
+	w := &bytes.Buffer{}
+	o := NumericOption{
+		Column:       "aaa",
+		MaxFloat:     100,
+		Distribution: "normal",
+		Mean:         100,
+		StdDev:       1,
+	}
+
+	if err := Numeric(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rec := range readCSV(w.String())[1:] {
+		i, err := strconv.Atoi(rec[0])
+		if err != nil || i < 0 || 100 <= i {
+			t.Fatalf("%s is out of range", rec[0])
+		}
+	}
+}
+
+func TestNumericWithStep(t *testing.T) {
+	r := bytes.NewBufferString(generateCSV("aaa,bbb", 100, func(int) string { return "1,2" }))
+	w := &bytes.Buffer{}
+	o := NumericOption{
+		Column:   "aaa",
+		MinFloat: 1000,
+		MaxFloat: 5000,
+		Step:     100,
+	}
+
+	if err := Numeric(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rec := range readCSV(w.String())[1:] {
+		i, err := strconv.Atoi(rec[0])
+		if err != nil || i%100 != 0 || i < 1000 || 5000 <= i {
+			t.Fatalf("%s is not multiple of 100 in range", rec[0])
+		}
+	}
+}
+
+func TestNumericWithEmptyRate(t *testing.T) {
+	r := bytes.NewBufferString(generateCSV("aaa,bbb", 10, func(int) string { return "1,2" }))
+	w := &bytes.Buffer{}
+	o := NumericOption{
+		Column:    "aaa",
+		MaxFloat:  100,
+		EmptyRate: 100,
+	}
+
+	if err := Numeric(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rec := range readCSV(w.String())[1:] {
+		if rec[0] != "" {
+			t.Fatalf("Expected empty, but got %s", rec[0])
+		}
+	}
+}
+
+func TestFormatNumeric(t *testing.T) {
+	tests := []struct {
+		opt      NumericOption
+		n        int64
+		expected string
+	}{
+		{NumericOption{}, 1234567, "1234567"},
+		{NumericOption{ThousandsSeparator: true}, 1234567, "1,234,567"},
+		{NumericOption{ThousandsSeparator: true}, -123456, "-123,456"},
+		{NumericOption{ThousandsSeparator: true}, 999, "999"},
+		{NumericOption{ThousandsSeparator: true, Prefix: "¥"}, 1000, "¥1,000"},
+		{NumericOption{ThousandsSeparator: true, Suffix: "円"}, 1000, "1,000円"},
+		{NumericOption{Decimal: true, DecimalDigit: 2, ThousandsSeparator: true, Prefix: "$"}, 123456, "$1,234.56"},
+		{NumericOption{Decimal: true, DecimalDigit: 3}, -5, "-0.005"},
+	}
+	for _, tt := range tests {
+		if actual := formatNumeric(tt.opt, tt.n); actual != tt.expected {
+			t.Errorf("Expected: %s, but got %s", tt.expected, actual)
+		}
+	}
+}
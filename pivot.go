@@ -0,0 +1,367 @@
+package csvutil
+
+import (
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var supportedAggregations = []string{"sum", "count", "min", "max", "avg", "first", "last"}
+
+type pivotCell struct {
+	count  int
+	values []string
+}
+
+func (c *pivotCell) aggregate(agg string) (string, error) {
+	switch agg {
+	case "count":
+		return strconv.Itoa(c.count), nil
+	case "first":
+		return c.values[0], nil
+	case "last":
+		return c.values[len(c.values)-1], nil
+	}
+
+	var nums []float64
+	for _, s := range c.values {
+		if s == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return "", errors.Errorf("not number value: %s", s)
+		}
+		nums = append(nums, f)
+	}
+	if len(nums) == 0 {
+		return "", nil
+	}
+
+	var n float64
+	switch agg {
+	case "sum", "avg":
+		for _, f := range nums {
+			n += f
+		}
+		if agg == "avg" {
+			n = n / float64(len(nums))
+		}
+	case "min":
+		n = math.Inf(1)
+		for _, f := range nums {
+			n = math.Min(n, f)
+		}
+	case "max":
+		n = math.Inf(-1)
+		for _, f := range nums {
+			n = math.Max(n, f)
+		}
+	}
+	return strconv.FormatFloat(n, 'f', -1, 64), nil
+}
+
+// PivotOption is option holder for Pivot.
+type PivotOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// RowSyms header or column index list that becomes line key.
+	RowSyms []string
+	// Column symbol whose values become headers.
+	Column string
+	// Column symbol whose values are aggregated.
+	Value string
+	// Aggregation method (sum, count, min, max, avg, first, last)
+	Aggregation string
+}
+
+func (o PivotOption) validate() error {
+	if len(o.RowSyms) == 0 {
+		return errors.New("no row column")
+	}
+	if o.Column == "" {
+		return errors.New("no column")
+	}
+	if o.Value == "" {
+		return errors.New("no value column")
+	}
+	if o.NoHeader {
+		for _, c := range o.RowSyms {
+			if !isDigit(c) {
+				return errors.Errorf("not number column symbol: %s", c)
+			}
+		}
+		if !isDigit(o.Column) {
+			return errors.New("not number column symbol")
+		}
+		if !isDigit(o.Value) {
+			return errors.New("not number value column symbol")
+		}
+	}
+	if !containsString(supportedAggregations, o.aggregation()) {
+		return errors.Errorf("unsupported aggregation: %s", o.Aggregation)
+	}
+	return nil
+}
+
+func (o PivotOption) aggregation() string {
+	if o.Aggregation == "" {
+		return "sum"
+	}
+	return o.Aggregation
+}
+
+func (o PivotOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Pivot converts CSV from long format to wide format.
+// Values of Column become headers, and values of Value are aggregated for each line key.
+func Pivot(r io.Reader, w io.Writer, o PivotOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+
+	var rows columns
+	var col, val *column
+	var rowHdr []string
+	csvp := NewReadOnlyCSVProcessor(cr)
+	if o.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			rows = newColumnsWithIndexes(o.RowSyms, nil)
+			col = newColumnWithIndex(o.Column, nil)
+			val = newColumnWithIndex(o.Value, nil)
+			rowHdr = make([]string, len(rows))
+			for i, row := range rows {
+				rowHdr[i] = "column" + strconv.Itoa(row.index+1)
+			}
+			return append(columns{col, val}, rows...).err()
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			rows = newColumnsWithIndexes(o.RowSyms, hdr)
+			col = newColumnWithIndex(o.Column, hdr)
+			val = newColumnWithIndex(o.Value, hdr)
+			if err := checkColumnsInRange(append(columns{col, val}, rows...), len(hdr)); err != nil {
+				return nil, err
+			}
+			rowHdr = extractFromRecord(hdr, rows)
+			return nil, nil
+		})
+	}
+
+	var keys, colVals []string
+	keyVals := make(map[string][]string)
+	cells := make(map[string]map[string]*pivotCell)
+	checked := !o.NoHeader
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		if !checked {
+			if err := checkColumnsInRange(append(columns{col, val}, rows...), len(rec)); err != nil {
+				return nil, err
+			}
+			checked = true
+		}
+		kv := extractFromRecord(rec, rows)
+		key := strings.Join(kv, "\x00")
+		if _, ok := cells[key]; !ok {
+			keys = append(keys, key)
+			keyVals[key] = kv
+			cells[key] = make(map[string]*pivotCell)
+		}
+		cv := rec[col.index]
+		if !containsString(colVals, cv) {
+			colVals = append(colVals, cv)
+		}
+		c, ok := cells[key][cv]
+		if !ok {
+			c = &pivotCell{}
+			cells[key][cv] = c
+		}
+		c.count++
+		c.values = append(c.values, rec[val.index])
+		return nil, nil
+	})
+	if err := csvp.Process(); err != nil {
+		return err
+	}
+
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+	if rowHdr == nil {
+		return nil
+	}
+	cw.Write(append(rowHdr, colVals...))
+	for _, key := range keys {
+		newRec := make([]string, 0, len(rowHdr)+len(colVals))
+		newRec = append(newRec, keyVals[key]...)
+		for _, cv := range colVals {
+			c, ok := cells[key][cv]
+			if !ok {
+				newRec = append(newRec, "")
+				continue
+			}
+			s, err := c.aggregate(o.aggregation())
+			if err != nil {
+				return err
+			}
+			newRec = append(newRec, s)
+		}
+		cw.Write(newRec)
+	}
+	return nil
+}
+
+// UnpivotOption is option holder for Unpivot.
+type UnpivotOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// KeepSyms header or column index list that is kept in each line.
+	KeepSyms []string
+	// ColumnSyms header or column index list that is unpivoted. (default all columns except KeepSyms)
+	ColumnSyms []string
+	// Header of column for unpivoted header. (default name)
+	Name string
+	// Header of column for unpivoted value. (default value)
+	Value string
+}
+
+func (o UnpivotOption) validate() error {
+	if len(o.KeepSyms) == 0 {
+		return errors.New("no keep column")
+	}
+	if o.NoHeader {
+		for _, c := range append(o.KeepSyms, o.ColumnSyms...) {
+			if !isDigit(c) {
+				return errors.Errorf("not number column symbol: %s", c)
+			}
+		}
+	}
+	return nil
+}
+
+func (o UnpivotOption) name() string {
+	if o.Name == "" {
+		return "name"
+	}
+	return o.Name
+}
+
+func (o UnpivotOption) value() string {
+	if o.Value == "" {
+		return "value"
+	}
+	return o.Value
+}
+
+func (o UnpivotOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Unpivot converts CSV from wide format to long format.
+// Each value of target columns is written as one line with kept columns.
+func Unpivot(r io.Reader, w io.Writer, o UnpivotOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	var keeps, targets columns
+	var names []string
+	setup := func(hdr []string, size int) error {
+		keeps = newUniqueColumns(o.KeepSyms, hdr)
+		if err := checkColumnsInRange(keeps, size); err != nil {
+			return err
+		}
+		if len(o.ColumnSyms) == 0 {
+			for i := 0; i < size; i++ {
+				if !containsColumnIndex(keeps, i) {
+					targets = append(targets, &column{symbol: strconv.Itoa(i), index: i})
+				}
+			}
+		} else {
+			targets = newUniqueColumns(o.ColumnSyms, hdr)
+			if err := checkColumnsInRange(targets, size); err != nil {
+				return err
+			}
+		}
+		names = make([]string, len(targets))
+		for i, t := range targets {
+			if hdr == nil {
+				names[i] = "column" + strconv.Itoa(t.index+1)
+			} else {
+				names[i] = hdr[t.index]
+			}
+		}
+		return nil
+	}
+
+	csvp := NewCSVProcessor(cr, cw)
+	if !o.NoHeader {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			if err := setup(hdr, len(hdr)); err != nil {
+				return nil, err
+			}
+			return append(extractFromRecord(hdr, keeps), o.name(), o.value()), nil
+		})
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		if keeps == nil {
+			if err := setup(nil, len(rec)); err != nil {
+				return nil, err
+			}
+		}
+		kv := extractFromRecord(rec, keeps)
+		for i, t := range targets {
+			newRec := make([]string, 0, len(kv)+2)
+			newRec = append(newRec, kv...)
+			cw.Write(append(newRec, names[i], rec[t.index]))
+		}
+		return nil, nil
+	})
+
+	return csvp.Process()
+}
+
+// checkColumnsInRange returns error of columns or error for index out of record size.
+func checkColumnsInRange(cols columns, size int) error {
+	if err := cols.err(); err != nil {
+		return err
+	}
+	for _, col := range cols {
+		if col.index >= size {
+			return errors.Errorf("column %s out of range", col.symbol)
+		}
+	}
+	return nil
+}
+
+func containsColumnIndex(cols columns, i int) bool {
+	for _, col := range cols {
+		if col.index == i {
+			return true
+		}
+	}
+	return false
+}
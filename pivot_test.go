@@ -0,0 +1,396 @@
+package csvutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+var pivotSourceCSV = `store,month,amount
+A,2024-01,100
+A,2024-02,200
+B,2024-01,300
+A,2024-01,50
+B,2024-02,
+`
+
+func TestPivotWithoutRow(t *testing.T) {
+	r := bytes.NewBufferString(pivotSourceCSV)
+	w := &bytes.Buffer{}
+	o := PivotOption{
+		Column: "month",
+		Value:  "amount",
+	}
+
+	if err := Pivot(r, w, o); err == nil {
+		t.Fatal("Pivot without row column should raise error.")
+	}
+}
+
+func TestPivotWithoutColumn(t *testing.T) {
+	r := bytes.NewBufferString(pivotSourceCSV)
+	w := &bytes.Buffer{}
+	o := PivotOption{
+		RowSyms: []string{"store"},
+		Value:   "amount",
+	}
+
+	if err := Pivot(r, w, o); err == nil {
+		t.Fatal("Pivot without column should raise error.")
+	}
+}
+
+func TestPivotWithoutValue(t *testing.T) {
+	r := bytes.NewBufferString(pivotSourceCSV)
+	w := &bytes.Buffer{}
+	o := PivotOption{
+		RowSyms: []string{"store"},
+		Column:  "month",
+	}
+
+	if err := Pivot(r, w, o); err == nil {
+		t.Fatal("Pivot without value column should raise error.")
+	}
+}
+
+func TestPivotWithNoHeaderButColumnNotNumber(t *testing.T) {
+	r := bytes.NewBufferString(pivotSourceCSV)
+	w := &bytes.Buffer{}
+	o := PivotOption{
+		NoHeader: true,
+		RowSyms:  []string{"0"},
+		Column:   "month",
+		Value:    "2",
+	}
+
+	if err := Pivot(r, w, o); err == nil {
+		t.Fatal("Pivot with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestPivotWithUnsupportedAggregation(t *testing.T) {
+	r := bytes.NewBufferString(pivotSourceCSV)
+	w := &bytes.Buffer{}
+	o := PivotOption{
+		RowSyms:     []string{"store"},
+		Column:      "month",
+		Value:       "amount",
+		Aggregation: "median",
+	}
+
+	if err := Pivot(r, w, o); err == nil {
+		t.Fatal("Pivot with unsupported aggregation should raise error.")
+	}
+}
+
+func TestPivotWithUnknownColumn(t *testing.T) {
+	r := bytes.NewBufferString(pivotSourceCSV)
+	w := &bytes.Buffer{}
+	o := PivotOption{
+		RowSyms: []string{"shop"},
+		Column:  "month",
+		Value:   "amount",
+	}
+
+	if err := Pivot(r, w, o); err == nil {
+		t.Fatal("Pivot with unknown column should raise error.")
+	}
+}
+
+func TestPivotWithOutOfRangeColumn(t *testing.T) {
+	for _, o := range []PivotOption{
+		{RowSyms: []string{"store"}, Column: "5", Value: "amount"},
+		{NoHeader: true, RowSyms: []string{"0"}, Column: "5", Value: "2"},
+		{NoHeader: true, RowSyms: []string{"0"}, Column: "1", Value: "5"},
+		{NoHeader: true, RowSyms: []string{"5"}, Column: "1", Value: "2"},
+	} {
+		r := bytes.NewBufferString(pivotSourceCSV)
+		w := &bytes.Buffer{}
+		if err := Pivot(r, w, o); err == nil {
+			t.Errorf("Pivot with out of range column should raise error. %+v", o)
+		}
+	}
+}
+
+func TestPivotWithNotNumberValue(t *testing.T) {
+	s := `store,month,amount
+A,2024-01,foo
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := PivotOption{
+		RowSyms: []string{"store"},
+		Column:  "month",
+		Value:   "amount",
+	}
+
+	if err := Pivot(r, w, o); err == nil {
+		t.Fatal("Pivot with not number value should raise error on sum.")
+	}
+}
+
+func TestPivotWithBrokenCSV(t *testing.T) {
+	s := `store,month,amount
+A,2024-01,100
+A,2024-02
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := PivotOption{
+		RowSyms: []string{"store"},
+		Column:  "month",
+		Value:   "amount",
+	}
+
+	if err := Pivot(r, w, o); err == nil {
+		t.Fatal("Pivot with broken csv should raise error.")
+	}
+}
+
+func TestPivot(t *testing.T) {
+	r := bytes.NewBufferString(pivotSourceCSV)
+	w := &bytes.Buffer{}
+	o := PivotOption{
+		RowSyms: []string{"store"},
+		Column:  "month",
+		Value:   "amount",
+	}
+
+	if err := Pivot(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `store,2024-01,2024-02
+A,150,200
+B,300,
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestPivotWithAggregations(t *testing.T) {
+	data := []struct {
+		agg      string
+		expected string
+	}{
+		{"count", "store,2024-01,2024-02\nA,2,1\nB,1,1\n"},
+		{"min", "store,2024-01,2024-02\nA,50,200\nB,300,\n"},
+		{"max", "store,2024-01,2024-02\nA,100,200\nB,300,\n"},
+		{"avg", "store,2024-01,2024-02\nA,75,200\nB,300,\n"},
+		{"first", "store,2024-01,2024-02\nA,100,200\nB,300,\n"},
+		{"last", "store,2024-01,2024-02\nA,50,200\nB,300,\n"},
+	}
+	for _, d := range data {
+		r := bytes.NewBufferString(pivotSourceCSV)
+		w := &bytes.Buffer{}
+		o := PivotOption{
+			RowSyms:     []string{"store"},
+			Column:      "month",
+			Value:       "amount",
+			Aggregation: d.agg,
+		}
+
+		if err := Pivot(r, w, o); err != nil {
+			t.Fatal(err)
+		}
+		if actual := w.String(); actual != d.expected {
+			t.Fatalf("%s: Expectd: %s, but got %s", d.agg, d.expected, actual)
+		}
+	}
+}
+
+func TestPivotWithNoHeader(t *testing.T) {
+	s := `A,2024-01,100
+A,2024-02,200
+B,2024-01,300
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := PivotOption{
+		NoHeader: true,
+		RowSyms:  []string{"0"},
+		Column:   "1",
+		Value:    "2",
+	}
+
+	if err := Pivot(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `column1,2024-01,2024-02
+A,100,200
+B,300,
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestUnpivotWithoutKeep(t *testing.T) {
+	s := `store,2024-01,2024-02
+A,150,200
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := UnpivotOption{}
+
+	if err := Unpivot(r, w, o); err == nil {
+		t.Fatal("Unpivot without keep column should raise error.")
+	}
+}
+
+func TestUnpivotWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := `A,150,200
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := UnpivotOption{
+		NoHeader: true,
+		KeepSyms: []string{"store"},
+	}
+
+	if err := Unpivot(r, w, o); err == nil {
+		t.Fatal("Unpivot with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestUnpivotWithUnknownColumn(t *testing.T) {
+	s := `store,2024-01,2024-02
+A,150,200
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := UnpivotOption{
+		KeepSyms: []string{"shop"},
+	}
+
+	if err := Unpivot(r, w, o); err == nil {
+		t.Fatal("Unpivot with unknown column should raise error.")
+	}
+}
+
+func TestUnpivotWithUnknownTargetColumn(t *testing.T) {
+	s := `store,2024-01,2024-02
+A,150,200
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := UnpivotOption{
+		KeepSyms:   []string{"store"},
+		ColumnSyms: []string{"nope"},
+	}
+
+	if err := Unpivot(r, w, o); err == nil {
+		t.Fatal("Unpivot with unknown target column should raise error.")
+	}
+}
+
+func TestUnpivotWithOutOfRangeColumn(t *testing.T) {
+	s := `store,2024-01,2024-02
+A,150,200
+`
+	for _, o := range []UnpivotOption{
+		{KeepSyms: []string{"store"}, ColumnSyms: []string{"3"}},
+		{KeepSyms: []string{"5"}},
+		{NoHeader: true, KeepSyms: []string{"0"}, ColumnSyms: []string{"9"}},
+	} {
+		r := bytes.NewBufferString(s)
+		w := &bytes.Buffer{}
+		if err := Unpivot(r, w, o); err == nil {
+			t.Errorf("Unpivot with out of range column should raise error. %+v", o)
+		}
+	}
+}
+
+func TestUnpivotWithBrokenCSV(t *testing.T) {
+	s := `store,2024-01,2024-02
+A,150,200
+B,300
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := UnpivotOption{
+		KeepSyms: []string{"store"},
+	}
+
+	if err := Unpivot(r, w, o); err == nil {
+		t.Fatal("Unpivot with broken csv should raise error.")
+	}
+}
+
+func TestUnpivot(t *testing.T) {
+	s := `store,2024-01,2024-02
+A,150,200
+B,300,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := UnpivotOption{
+		KeepSyms: []string{"store"},
+		Name:     "month",
+		Value:    "amount",
+	}
+
+	if err := Unpivot(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `store,month,amount
+A,2024-01,150
+A,2024-02,200
+B,2024-01,300
+B,2024-02,
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestUnpivotWithColumnSyms(t *testing.T) {
+	s := `store,memo,2024-01,2024-02
+A,x,150,200
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := UnpivotOption{
+		KeepSyms:   []string{"store"},
+		ColumnSyms: []string{"2024-01", "2024-02"},
+	}
+
+	if err := Unpivot(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `store,name,value
+A,2024-01,150
+A,2024-02,200
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestUnpivotWithNoHeader(t *testing.T) {
+	s := `A,150,200
+B,300,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := UnpivotOption{
+		NoHeader: true,
+		KeepSyms: []string{"0"},
+	}
+
+	if err := Unpivot(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `A,column2,150
+A,column3,200
+B,column2,300
+B,column3,
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
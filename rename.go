@@ -0,0 +1,276 @@
+package csvutil
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var sedBackrefRegex = regexp.MustCompile(`\\(\d)`)
+
+type renameExpr struct {
+	regex       *regexp.Regexp
+	replacement string
+	global      bool
+}
+
+func (e *renameExpr) rename(s string) string {
+	if e.global {
+		return e.regex.ReplaceAllString(s, e.replacement)
+	}
+	loc := e.regex.FindStringSubmatchIndex(s)
+	if loc == nil {
+		return s
+	}
+	dst := e.regex.ExpandString(nil, e.replacement, s, loc)
+	return s[:loc[0]] + string(dst) + s[loc[1]:]
+}
+
+// parseRenameExpr parses sed style substitution like s/pattern/replacement/flags.
+// Supported flags are g (replace all) and i (ignore case).
+func parseRenameExpr(expr string) (*renameExpr, error) {
+	if len(expr) < 4 || expr[0] != 's' {
+		return nil, errors.Errorf("invalid expression: %s", expr)
+	}
+	d := expr[1:2]
+	parts := strings.Split(expr[2:], d)
+	if len(parts) != 3 {
+		return nil, errors.Errorf("invalid expression: %s", expr)
+	}
+	p := parts[0]
+	e := &renameExpr{}
+	for _, f := range parts[2] {
+		switch f {
+		case 'g':
+			e.global = true
+		case 'i':
+			p = "(?i)" + p
+		default:
+			return nil, errors.Errorf("unsupported flag %q: %s", f, expr)
+		}
+	}
+	r, err := regexp.Compile(p)
+	if err != nil {
+		return nil, err
+	}
+	e.regex = r
+	e.replacement = sedBackrefRegex.ReplaceAllString(parts[1], "$${$1}")
+	return e, nil
+}
+
+// RenameOption is option holder for Rename.
+type RenameOption struct {
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// Map is pairs of column symbol and new header text.
+	Map map[string]string
+	// Expressions are sed style rename rules. (e.g. s/^col_//)
+	Expressions []string
+	exprs       []*renameExpr
+}
+
+func (o *RenameOption) validate() error {
+	if len(o.Map) == 0 && len(o.Expressions) == 0 {
+		return errors.New("no rename rule")
+	}
+	for _, s := range o.Expressions {
+		e, err := parseRenameExpr(s)
+		if err != nil {
+			return err
+		}
+		o.exprs = append(o.exprs, e)
+	}
+	return nil
+}
+
+func (o RenameOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Rename headers of CSV.
+func Rename(r io.Reader, w io.Writer, o RenameOption) error {
+	opt := &o
+	if err := opt.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, opt.Encoding)
+	cw := writer(w, bom, opt.outputEncoding())
+	defer cw.Flush()
+
+	csvp := NewCSVProcessor(cr, cw)
+	csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+		newHdr := make([]string, len(hdr))
+		copy(newHdr, hdr)
+		for sym, name := range opt.Map {
+			col := newColumnWithIndex(sym, hdr)
+			if col.err != nil {
+				return nil, col.err
+			}
+			if col.index >= len(hdr) {
+				return nil, errors.Errorf("column %s not found", sym)
+			}
+			newHdr[col.index] = name
+		}
+		for i, h := range newHdr {
+			for _, e := range opt.exprs {
+				h = e.rename(h)
+			}
+			newHdr[i] = h
+		}
+		return newHdr, nil
+	})
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		return rec, nil
+	})
+
+	return csvp.Process()
+}
+
+// MoveOption is option holder for Move.
+type MoveOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// ColumnSyms header or column index list to move.
+	ColumnSyms []string
+	// Before is column symbol that moved columns are placed before.
+	Before string
+	// After is column symbol that moved columns are placed after.
+	After string
+	// ToStart moves columns to the first.
+	ToStart bool
+	// ToEnd moves columns to the last.
+	ToEnd bool
+}
+
+func (o MoveOption) validate() error {
+	if len(o.ColumnSyms) == 0 {
+		return errors.New("no column")
+	}
+	if o.NoHeader {
+		for _, c := range append([]string{o.Before, o.After}, o.ColumnSyms...) {
+			if !isEmptyOrDigit(c) {
+				return errors.Errorf("not number column symbol: %s", c)
+			}
+		}
+	}
+	n := 0
+	for _, b := range []bool{o.Before != "", o.After != "", o.ToStart, o.ToEnd} {
+		if b {
+			n++
+		}
+	}
+	if n != 1 {
+		return errors.New("required only one of before, after, to-start and to-end")
+	}
+	return nil
+}
+
+func (o MoveOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Move column(s) to given position.
+// Other columns are kept in original order.
+func Move(r io.Reader, w io.Writer, o MoveOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	var order columns
+	csvp := NewCSVProcessor(cr, cw)
+	if !o.NoHeader {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			var err error
+			order, err = moveOrder(o, hdr, len(hdr))
+			if err != nil {
+				return nil, err
+			}
+			return extractFromRecord(hdr, order), nil
+		})
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		if order == nil {
+			var err error
+			order, err = moveOrder(o, nil, len(rec))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return extractFromRecord(rec, order), nil
+	})
+
+	return csvp.Process()
+}
+
+func moveOrder(o MoveOption, hdr []string, size int) (columns, error) {
+	moved := newUniqueColumns(o.ColumnSyms, hdr)
+	if err := moved.err(); err != nil {
+		return nil, err
+	}
+	for _, col := range moved {
+		if col.index >= size {
+			return nil, errors.Errorf("column %s not found", col.symbol)
+		}
+	}
+
+	var rest columns
+	for i := 0; i < size; i++ {
+		if !containsColumnIndex(moved, i) {
+			rest = append(rest, &column{symbol: strconv.Itoa(i), index: i})
+		}
+	}
+
+	pos := len(rest)
+	if o.ToStart {
+		pos = 0
+	} else if o.Before != "" || o.After != "" {
+		sym := o.Before
+		if sym == "" {
+			sym = o.After
+		}
+		base := newColumnWithIndex(sym, hdr)
+		if base.err != nil {
+			return nil, base.err
+		}
+		if containsColumnIndex(moved, base.index) {
+			return nil, errors.Errorf("column %s is moved column", sym)
+		}
+		pos = -1
+		for i, col := range rest {
+			if col.index == base.index {
+				pos = i
+				if o.After != "" {
+					pos++
+				}
+			}
+		}
+		if pos == -1 {
+			return nil, errors.Errorf("column %s not found", sym)
+		}
+	}
+
+	order := make(columns, 0, size)
+	order = append(order, rest[:pos]...)
+	order = append(order, moved...)
+	return append(order, rest[pos:]...), nil
+}
@@ -0,0 +1,295 @@
+package csvutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRenameWithoutRule(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := RenameOption{}
+
+	if err := Rename(r, w, o); err == nil {
+		t.Fatal("Rename without rule should raise error.")
+	}
+}
+
+func TestRenameWithInvalidExpression(t *testing.T) {
+	data := []string{"^col_", "s/^col_/", "s/[/x/", "s/a/b/x"}
+	for _, expr := range data {
+		r := bytes.NewBufferString("aaa,bbb\n1,2\n")
+		w := &bytes.Buffer{}
+		o := RenameOption{
+			Expressions: []string{expr},
+		}
+
+		if err := Rename(r, w, o); err == nil {
+			t.Fatalf("Rename with invalid expression %s should raise error.", expr)
+		}
+	}
+}
+
+func TestRenameWithUnknownColumn(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := RenameOption{
+		Map: map[string]string{"ddd": "foo"},
+	}
+
+	if err := Rename(r, w, o); err == nil {
+		t.Fatal("Rename with unknown column should raise error.")
+	}
+}
+
+func TestRenameWithBrokenCSV(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := RenameOption{
+		Map: map[string]string{"aaa": "foo"},
+	}
+
+	if err := Rename(r, w, o); err == nil {
+		t.Fatal("Rename with broken csv should raise error.")
+	}
+}
+
+func TestRename(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := RenameOption{
+		Map: map[string]string{"aaa": "foo", "2": "bar"},
+	}
+
+	if err := Rename(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `foo,bbb,bar
+1,2,3
+4,5,6
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestRenameWithExpressions(t *testing.T) {
+	s := `col_aaa,COL_bbb,col_c_col
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := RenameOption{
+		Expressions: []string{"s/^col_//i", "s|(\\w)_(\\w)|\\2-\\1|g"},
+	}
+
+	if err := Rename(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,c-col
+1,2,3
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestRenameWithNotGlobalExpression(t *testing.T) {
+	s := `a_b_c
+1
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := RenameOption{
+		Expressions: []string{"s/_/-/"},
+	}
+
+	if err := Rename(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `a-b_c
+1
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestMoveWithoutColumn(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MoveOption{
+		ToEnd: true,
+	}
+
+	if err := Move(r, w, o); err == nil {
+		t.Fatal("Move without column should raise error.")
+	}
+}
+
+func TestMoveWithoutPosition(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MoveOption{
+		ColumnSyms: []string{"aaa"},
+	}
+
+	if err := Move(r, w, o); err == nil {
+		t.Fatal("Move without position should raise error.")
+	}
+}
+
+func TestMoveWithMultiplePositions(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MoveOption{
+		ColumnSyms: []string{"aaa"},
+		Before:     "ccc",
+		ToEnd:      true,
+	}
+
+	if err := Move(r, w, o); err == nil {
+		t.Fatal("Move with multiple positions should raise error.")
+	}
+}
+
+func TestMoveWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := `1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MoveOption{
+		NoHeader:   true,
+		ColumnSyms: []string{"aaa"},
+		ToEnd:      true,
+	}
+
+	if err := Move(r, w, o); err == nil {
+		t.Fatal("Move with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestMoveWithUnknownColumn(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MoveOption{
+		ColumnSyms: []string{"aaa"},
+		Before:     "ddd",
+	}
+
+	if err := Move(r, w, o); err == nil {
+		t.Fatal("Move with unknown column should raise error.")
+	}
+}
+
+func TestMoveBeforeMovedColumn(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MoveOption{
+		ColumnSyms: []string{"aaa", "bbb"},
+		Before:     "bbb",
+	}
+
+	if err := Move(r, w, o); err == nil {
+		t.Fatal("Move before moved column should raise error.")
+	}
+}
+
+func TestMoveWithBrokenCSV(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MoveOption{
+		ColumnSyms: []string{"aaa"},
+		ToEnd:      true,
+	}
+
+	if err := Move(r, w, o); err == nil {
+		t.Fatal("Move with broken csv should raise error.")
+	}
+}
+
+func TestMove(t *testing.T) {
+	s := `id,name,age,memo
+1,foo,20,x
+2,bar,30,y
+`
+	data := []struct {
+		o        MoveOption
+		expected string
+	}{
+		{MoveOption{ColumnSyms: []string{"memo"}, Before: "name"}, "id,memo,name,age\n1,x,foo,20\n2,y,bar,30\n"},
+		{MoveOption{ColumnSyms: []string{"id"}, After: "age"}, "name,age,id,memo\nfoo,20,1,x\nbar,30,2,y\n"},
+		{MoveOption{ColumnSyms: []string{"memo", "age"}, ToStart: true}, "memo,age,id,name\nx,20,1,foo\ny,30,2,bar\n"},
+		{MoveOption{ColumnSyms: []string{"id"}, ToEnd: true}, "name,age,memo,id\nfoo,20,x,1\nbar,30,y,2\n"},
+	}
+	for _, d := range data {
+		r := bytes.NewBufferString(s)
+		w := &bytes.Buffer{}
+		if err := Move(r, w, d.o); err != nil {
+			t.Fatal(err)
+		}
+		if actual := w.String(); actual != d.expected {
+			t.Fatalf("Expectd: %s, but got %s", d.expected, actual)
+		}
+	}
+}
+
+func TestMoveWithNoHeader(t *testing.T) {
+	s := `1,foo,20,x
+2,bar,30,y
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := MoveOption{
+		NoHeader:   true,
+		ColumnSyms: []string{"3"},
+		Before:     "1",
+	}
+
+	if err := Move(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `1,x,foo,20
+2,y,bar,30
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
@@ -0,0 +1,111 @@
+package csvutil
+
+import (
+	"bytes"
+	"strings"
+)
+
+// romajiMap maps hiragana to Hepburn romaji.
+var romajiMap = map[string]string{
+	"あ": "a", "い": "i", "う": "u", "え": "e", "お": "o",
+	"か": "ka", "き": "ki", "く": "ku", "け": "ke", "こ": "ko",
+	"さ": "sa", "し": "shi", "す": "su", "せ": "se", "そ": "so",
+	"た": "ta", "ち": "chi", "つ": "tsu", "て": "te", "と": "to",
+	"な": "na", "に": "ni", "ぬ": "nu", "ね": "ne", "の": "no",
+	"は": "ha", "ひ": "hi", "ふ": "fu", "へ": "he", "ほ": "ho",
+	"ま": "ma", "み": "mi", "む": "mu", "め": "me", "も": "mo",
+	"や": "ya", "ゆ": "yu", "よ": "yo",
+	"ら": "ra", "り": "ri", "る": "ru", "れ": "re", "ろ": "ro",
+	"わ": "wa", "ゐ": "i", "ゑ": "e", "を": "o", "ん": "n",
+	"が": "ga", "ぎ": "gi", "ぐ": "gu", "げ": "ge", "ご": "go",
+	"ざ": "za", "じ": "ji", "ず": "zu", "ぜ": "ze", "ぞ": "zo",
+	"だ": "da", "ぢ": "ji", "づ": "zu", "で": "de", "ど": "do",
+	"ば": "ba", "び": "bi", "ぶ": "bu", "べ": "be", "ぼ": "bo",
+	"ぱ": "pa", "ぴ": "pi", "ぷ": "pu", "ぺ": "pe", "ぽ": "po",
+	"ゔ": "vu",
+	"ぁ": "a", "ぃ": "i", "ぅ": "u", "ぇ": "e", "ぉ": "o",
+	"ゃ": "ya", "ゅ": "yu", "ょ": "yo",
+	"きゃ": "kya", "きゅ": "kyu", "きょ": "kyo",
+	"しゃ": "sha", "しゅ": "shu", "しょ": "sho",
+	"ちゃ": "cha", "ちゅ": "chu", "ちょ": "cho",
+	"にゃ": "nya", "にゅ": "nyu", "にょ": "nyo",
+	"ひゃ": "hya", "ひゅ": "hyu", "ひょ": "hyo",
+	"みゃ": "mya", "みゅ": "myu", "みょ": "myo",
+	"りゃ": "rya", "りゅ": "ryu", "りょ": "ryo",
+	"ぎゃ": "gya", "ぎゅ": "gyu", "ぎょ": "gyo",
+	"じゃ": "ja", "じゅ": "ju", "じょ": "jo",
+	"ぢゃ": "ja", "ぢゅ": "ju", "ぢょ": "jo",
+	"びゃ": "bya", "びゅ": "byu", "びょ": "byo",
+	"ぴゃ": "pya", "ぴゅ": "pyu", "ぴょ": "pyo",
+}
+
+// toRomaji converts hiragana to Hepburn romaji in passport style.
+// Long vowels (ou, oo, uu) are shortened and n before b, m or p is written as m.
+// Characters that are not hiragana are output as is.
+func toRomaji(s string) string {
+	rs := []rune(s)
+	var syls []string
+	for i := 0; i < len(rs); i++ {
+		if i+1 < len(rs) {
+			if r, ok := romajiMap[string(rs[i:i+2])]; ok {
+				syls = append(syls, r)
+				i++
+				continue
+			}
+		}
+		if r, ok := romajiMap[string(rs[i])]; ok {
+			syls = append(syls, r)
+		} else if rs[i] == 'っ' {
+			syls = append(syls, "っ")
+		} else if rs[i] != 'ー' {
+			syls = append(syls, string(rs[i]))
+		}
+	}
+
+	b := &bytes.Buffer{}
+	for i, syl := range syls {
+		var next string
+		if i+1 < len(syls) {
+			next = syls[i+1]
+		}
+		switch {
+		case syl == "っ":
+			if strings.HasPrefix(next, "ch") {
+				b.WriteString("t")
+			} else if next != "" {
+				b.WriteString(next[:1])
+			}
+		case syl == "n" && next != "" && strings.ContainsAny(next[:1], "bmp"):
+			b.WriteString("m")
+		case (syl == "o" || syl == "u") && isLongVowel(b.String(), syl, next):
+			// long vowel is omitted.
+		default:
+			b.WriteString(syl)
+		}
+	}
+	return b.String()
+}
+
+// isLongVowel reports whether vowel extends previous syllable. (ou, oo, uu)
+// Vowel followed by another vowel (e.g. u of いのうえ) is not treated as long vowel.
+func isLongVowel(prev string, v string, next string) bool {
+	if prev == "" || (next != "" && strings.ContainsAny(next[:1], "aiueo")) {
+		return false
+	}
+	last := prev[len(prev)-1:]
+	if v == "u" {
+		return last == "o" || last == "u"
+	}
+	return last == "o"
+}
+
+// romajiCase converts case of romaji. (upper or title)
+func romajiCase(s string, c string) string {
+	if c == "upper" {
+		return strings.ToUpper(s)
+	}
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
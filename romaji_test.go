@@ -0,0 +1,48 @@
+package csvutil
+
+import "testing"
+
+func TestToRomaji(t *testing.T) {
+	data := []struct {
+		hiragana string
+		romaji   string
+	}{
+		{"やまだ", "yamada"},
+		{"たろう", "taro"},
+		{"さとう", "sato"},
+		{"おおの", "ono"},
+		{"ゆうこ", "yuko"},
+		{"いのうえ", "inoue"},
+		{"しょうへい", "shohei"},
+		{"じゅんいち", "junichi"},
+		{"ちひろ", "chihiro"},
+		{"つよし", "tsuyoshi"},
+		{"ふじ", "fuji"},
+		{"はっとり", "hattori"},
+		{"ほった", "hotta"},
+		{"はっちょう", "hatcho"},
+		{"なんば", "namba"},
+		{"しんぺい", "shimpei"},
+		{"かんの", "kanno"},
+		{"きょうこ", "kyoko"},
+		{"りゅうじ", "ryuji"},
+		{"ちゃこ", "chako"},
+	}
+	for _, d := range data {
+		if r := toRomaji(d.hiragana); r != d.romaji {
+			t.Errorf("Expected %s for %s, but got %s", d.romaji, d.hiragana, r)
+		}
+	}
+}
+
+func TestRomajiCase(t *testing.T) {
+	if s := romajiCase("yamada", "upper"); s != "YAMADA" {
+		t.Errorf("Expected YAMADA, but got %s", s)
+	}
+	if s := romajiCase("yamada", "title"); s != "Yamada" {
+		t.Errorf("Expected Yamada, but got %s", s)
+	}
+	if s := romajiCase("", "title"); s != "" {
+		t.Errorf("Expected empty, but got %s", s)
+	}
+}
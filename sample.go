@@ -0,0 +1,168 @@
+package csvutil
+
+import (
+	"io"
+	"math/rand"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+type sampledRecord struct {
+	index int
+	rec   []string
+}
+
+type reservoir struct {
+	size  int
+	seen  int
+	items []*sampledRecord
+}
+
+func (rsv *reservoir) add(rnd *rand.Rand, sr *sampledRecord) {
+	rsv.seen++
+	if len(rsv.items) < rsv.size {
+		rsv.items = append(rsv.items, sr)
+		return
+	}
+	if i := rnd.Intn(rsv.seen); i < rsv.size {
+		rsv.items[i] = sr
+	}
+}
+
+// SampleOption is option holder for Sample.
+type SampleOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// Count is sampling line count. (per group when Column is given)
+	Count int
+	// Rate is sampling percentage. (rounded line count per group when Column is given)
+	Rate float64
+	// Column symbol for stratified sampling.
+	Column string
+	// Seed for random. (0 means random seed)
+	Seed int64
+}
+
+func (o SampleOption) validate() error {
+	if o.Count < 0 {
+		return errors.New("negative count")
+	}
+	if o.Rate < 0 || 100 < o.Rate {
+		return errors.New("invalid rate (0 <= rate <= 100)")
+	}
+	if o.Count == 0 && o.Rate == 0 {
+		return errors.New("required count or rate")
+	}
+	if o.Count != 0 && o.Rate != 0 {
+		return errors.New("count and rate cannot be used together")
+	}
+	if o.NoHeader {
+		if !isEmptyOrDigit(o.Column) {
+			return errors.New("not number column symbol")
+		}
+	}
+	return nil
+}
+
+func (o SampleOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Sample reads lines at random.
+// When Count is given, lines are sampled by reservoir sampling, and when Rate is given, each line is sampled in given rate.
+// When Rate is given with Column, lines of rounded rate count are sampled from each group.
+// Sampled lines are written in original order.
+func Sample(r io.Reader, w io.Writer, o SampleOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	rnd := newRand(o.Seed)
+	var col *column
+	var keys []string
+	rsvs := make(map[string]*reservoir)
+	grps := make(map[string][]*sampledRecord)
+	i := 0
+	csvp := NewCSVProcessor(cr, cw)
+	if o.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			col = newColumnWithIndex(o.Column, nil)
+			return col.err
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			col = newColumnWithIndex(o.Column, hdr)
+			return hdr, col.err
+		})
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		if o.Rate != 0 && col.index == -1 {
+			if rnd.Float64()*100 < o.Rate {
+				return rec, nil
+			}
+			return nil, nil
+		}
+
+		var key string
+		if col.index != -1 {
+			key = rec[col.index]
+		}
+		sr := &sampledRecord{index: i, rec: rec}
+		i++
+		if o.Rate != 0 {
+			if _, ok := grps[key]; !ok {
+				keys = append(keys, key)
+			}
+			grps[key] = append(grps[key], sr)
+			return nil, nil
+		}
+		rsv, ok := rsvs[key]
+		if !ok {
+			rsv = &reservoir{size: o.Count}
+			rsvs[key] = rsv
+			keys = append(keys, key)
+		}
+		rsv.add(rnd, sr)
+		return nil, nil
+	})
+	if err := csvp.Process(); err != nil {
+		return err
+	}
+
+	var srs []*sampledRecord
+	for _, key := range keys {
+		if o.Rate != 0 {
+			srs = append(srs, sampleByRate(rnd, grps[key], o.Rate)...)
+		} else {
+			srs = append(srs, rsvs[key].items...)
+		}
+	}
+	sort.Slice(srs, func(i, j int) bool {
+		return srs[i].index < srs[j].index
+	})
+	for _, sr := range srs {
+		cw.Write(sr.rec)
+	}
+	return nil
+}
+
+func sampleByRate(rnd *rand.Rand, srs []*sampledRecord, rate float64) []*sampledRecord {
+	n := int(float64(len(srs))*rate/100 + 0.5)
+	sampled := make([]*sampledRecord, n)
+	for i, j := range rnd.Perm(len(srs))[:n] {
+		sampled[i] = srs[j]
+	}
+	return sampled
+}
@@ -0,0 +1,290 @@
+package csvutil
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strconv"
+	"testing"
+)
+
+func BenchmarkSample(b *testing.B) {
+	p, err := ioutil.ReadFile("testdata/bench.csv")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < b.N; i++ {
+		r := bytes.NewBuffer(p)
+		w := &bytes.Buffer{}
+		o := SampleOption{
+			Count: 100,
+		}
+		Sample(r, w, o)
+	}
+}
+
+func sampleLine(i int) string {
+	g := "a"
+	if i%4 == 0 {
+		g = "b"
+	}
+	return strconv.Itoa(i) + "," + g
+}
+
+func TestSampleWithoutCountAndRate(t *testing.T) {
+	s := `id,group
+1,a
+2,a
+3,a
+4,b
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SampleOption{}
+
+	if err := Sample(r, w, o); err == nil {
+		t.Fatal("Sample without count and rate should raise error.")
+	}
+}
+
+func TestSampleWithCountAndRate(t *testing.T) {
+	s := `id,group
+1,a
+2,a
+3,a
+4,b
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SampleOption{
+		Count: 3,
+		Rate:  10,
+	}
+
+	if err := Sample(r, w, o); err == nil {
+		t.Fatal("Sample with both count and rate should raise error.")
+	}
+}
+
+func TestSampleWithNegativeCount(t *testing.T) {
+	s := `id,group
+1,a
+2,a
+3,a
+4,b
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SampleOption{
+		Count: -1,
+	}
+
+	if err := Sample(r, w, o); err == nil {
+		t.Fatal("Sample with negative count should raise error.")
+	}
+}
+
+func TestSampleWithOver100Rate(t *testing.T) {
+	s := `id,group
+1,a
+2,a
+3,a
+4,b
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SampleOption{
+		Rate: 100.1,
+	}
+
+	if err := Sample(r, w, o); err == nil {
+		t.Fatal("Sample with over 100 rate should raise error.")
+	}
+}
+
+func TestSampleWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := `id,group
+1,a
+2,a
+3,a
+4,b
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SampleOption{
+		NoHeader: true,
+		Count:    3,
+		Column:   "group",
+	}
+
+	if err := Sample(r, w, o); err == nil {
+		t.Fatal("Sample with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestSampleWithUnknownColumn(t *testing.T) {
+	s := `id,group
+1,a
+2,a
+3,a
+4,b
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SampleOption{
+		Count:  3,
+		Column: "foo",
+	}
+
+	if err := Sample(r, w, o); err == nil {
+		t.Fatal("Sample with unknown column should raise error.")
+	}
+}
+
+func TestSampleWithBrokenCSV(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5
+7,8,9
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SampleOption{
+		Count: 1,
+	}
+
+	if err := Sample(r, w, o); err == nil {
+		t.Fatal("Sample with broken csv should raise error.")
+	}
+}
+
+func TestSampleWithCount(t *testing.T) {
+	r := bytes.NewBufferString(generateCSV("id,group", 100, sampleLine))
+	w := &bytes.Buffer{}
+	o := SampleOption{
+		Count: 10,
+	}
+
+	if err := Sample(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	actual := readCSV(w.String())
+	if len(actual) != 11 {
+		t.Fatalf("Sample should output 10 lines, but got %d", len(actual)-1)
+	}
+	if actual[0][0] != "id" {
+		t.Fatalf("Header should be kept: %v", actual[0])
+	}
+	prev := 0
+	for _, rec := range actual[1:] {
+		n, _ := strconv.Atoi(rec[0])
+		if n <= prev {
+			t.Fatalf("Sampled lines should be in original order: %v", actual)
+		}
+		prev = n
+	}
+}
+
+func TestSampleWithCountGreaterThanLines(t *testing.T) {
+	s := `id,group
+1,a
+2,a
+3,a
+4,b
+5,a
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SampleOption{
+		Count: 10,
+	}
+
+	if err := Sample(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	if actual := w.String(); actual != s {
+		t.Fatalf("Expectd: %s, but got %s", s, actual)
+	}
+}
+
+func TestSampleWithRate(t *testing.T) {
+	r := bytes.NewBufferString(generateCSV("id,group", 1000, sampleLine))
+	w := &bytes.Buffer{}
+	o := SampleOption{
+		Rate: 10,
+	}
+
+	if err := Sample(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	actual := readCSV(w.String())
+	if l := len(actual) - 1; l < 50 || 150 < l {
+		t.Fatalf("Sample with 10%% rate should output about 100 lines, but got %d", l)
+	}
+}
+
+func TestSampleWithColumn(t *testing.T) {
+	r := bytes.NewBufferString(generateCSV("id,group", 100, sampleLine))
+	w := &bytes.Buffer{}
+	o := SampleOption{
+		Count:  5,
+		Column: "group",
+	}
+
+	if err := Sample(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	actual := readCSV(w.String())
+	counts := make(map[string]int)
+	for _, rec := range actual[1:] {
+		counts[rec[1]]++
+	}
+	if counts["a"] != 5 || counts["b"] != 5 {
+		t.Fatalf("Sample should output 5 lines per group, but got %v", counts)
+	}
+}
+
+func TestSampleWithRateAndColumn(t *testing.T) {
+	r := bytes.NewBufferString(generateCSV("id,group", 100, sampleLine))
+	w := &bytes.Buffer{}
+	o := SampleOption{
+		Rate:   20,
+		Column: "group",
+	}
+
+	if err := Sample(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	actual := readCSV(w.String())
+	counts := make(map[string]int)
+	for _, rec := range actual[1:] {
+		counts[rec[1]]++
+	}
+	if counts["a"] != 15 || counts["b"] != 5 {
+		t.Fatalf("Sample should output 20%% lines per group, but got %v", counts)
+	}
+}
+
+func TestSampleWithSeed(t *testing.T) {
+	s := generateCSV("id,group", 100, sampleLine)
+	w1 := &bytes.Buffer{}
+	w2 := &bytes.Buffer{}
+	o := SampleOption{
+		Count: 10,
+		Seed:  42,
+	}
+
+	if err := Sample(bytes.NewBufferString(s), w1, o); err != nil {
+		t.Fatal(err)
+	}
+	if err := Sample(bytes.NewBufferString(s), w2, o); err != nil {
+		t.Fatal(err)
+	}
+	if w1.String() != w2.String() {
+		t.Fatalf("Sample with same seed should output same lines: %s, %s", w1.String(), w2.String())
+	}
+}
@@ -0,0 +1,229 @@
+package csvutil
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var designatedCities = []string{
+	"札幌市",
+	"仙台市",
+	"さいたま市",
+	"千葉市",
+	"横浜市",
+	"川崎市",
+	"相模原市",
+	"新潟市",
+	"静岡市",
+	"浜松市",
+	"名古屋市",
+	"京都市",
+	"大阪市",
+	"堺市",
+	"神戸市",
+	"岡山市",
+	"広島市",
+	"北九州市",
+	"福岡市",
+	"熊本市",
+}
+
+// Cities that contain 市 before the last 市.
+var irregularCities = []string{
+	"四日市市",
+	"廿日市市",
+	"野々市市",
+}
+
+// irregularCountyTowns are towns that 市 is contained in county or town name.
+var irregularCountyTowns = []string{
+	"余市郡余市町",
+	"余市郡仁木町",
+	"余市郡赤井川村",
+	"芳賀郡市貝町",
+	"中新川郡上市町",
+	"西八代郡市川三郷町",
+	"高市郡高取町",
+	"高市郡明日香村",
+	"吉野郡下市町",
+}
+
+var (
+	countyTownRegex = regexp.MustCompile(`^([^市]+?郡[^市]+?[町村])`)
+	wardRegex       = regexp.MustCompile(`^([^市]+?区)`)
+	cityRegex       = regexp.MustCompile(`^(.+?市)`)
+	townRegex       = regexp.MustCompile(`^(.+?[町村])`)
+)
+
+// SeparateOption is option holder for Separate.
+type SeparateOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// Target column symbol.
+	Column string
+	// Into is header list of separated columns.
+	Into []string
+	// Delimiter for separating.
+	Delimiter string
+	// Regexp that has capture groups for separating.
+	Regexp string
+	// Separate Japanese address into prefecture, city and town.
+	Address bool
+	// Keep target column.
+	Keep  bool
+	regex *regexp.Regexp
+}
+
+func (o *SeparateOption) validate() error {
+	if o.Column == "" {
+		return errors.New("no column")
+	}
+	if o.NoHeader {
+		if !isDigit(o.Column) {
+			return errors.New("not number column symbol")
+		}
+	}
+	if len(o.Into) == 0 {
+		return errors.New("no separated column")
+	}
+	if o.Regexp != "" && o.Address {
+		return errors.New("regexp and address cannot be used together")
+	}
+	if o.Regexp != "" {
+		r, err := regexp.Compile(o.Regexp)
+		if err != nil {
+			return err
+		}
+		if r.NumSubexp() != len(o.Into) {
+			return errors.New("count of capture groups does not match count of separated columns")
+		}
+		o.regex = r
+	} else if o.Address {
+		if len(o.Into) != 3 {
+			return errors.New("address should be separated into 3 columns")
+		}
+	} else if o.Delimiter == "" {
+		return errors.New("no delimiter")
+	}
+	return nil
+}
+
+func (o *SeparateOption) separate(s string) []string {
+	vals := make([]string, len(o.Into))
+	if o.regex != nil {
+		m := o.regex.FindStringSubmatch(s)
+		if m != nil {
+			copy(vals, m[1:])
+		}
+	} else if o.Address {
+		vals[0], vals[1], vals[2] = separateAddress(s)
+	} else {
+		copy(vals, strings.SplitN(s, o.Delimiter, len(o.Into)))
+	}
+	return vals
+}
+
+func (o SeparateOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Separate value of given column into several columns.
+func Separate(r io.Reader, w io.Writer, o SeparateOption) error {
+	opt := &o
+	if err := opt.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, opt.Encoding)
+	cw := writer(w, bom, opt.outputEncoding())
+	defer cw.Flush()
+
+	var col *column
+	csvp := NewCSVProcessor(cr, cw)
+	if opt.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			col = newColumnWithIndex(opt.Column, nil)
+			return col.err
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			col = newColumnWithIndex(opt.Column, hdr)
+			if col.err != nil {
+				return nil, col.err
+			}
+			return separateTo(hdr, col, opt.Into, opt.Keep), nil
+		})
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		return separateTo(rec, col, opt.separate(rec[col.index]), opt.Keep), nil
+	})
+
+	return csvp.Process()
+}
+
+func separateTo(rec []string, col *column, vals []string, keep bool) []string {
+	newRec := make([]string, 0, len(rec)+len(vals))
+	newRec = append(newRec, rec[:col.index]...)
+	if keep {
+		newRec = append(newRec, rec[col.index])
+	}
+	newRec = append(newRec, vals...)
+	return append(newRec, rec[col.index+1:]...)
+}
+
+func separateAddress(s string) (string, string, string) {
+	var pref string
+	for _, p := range prefs {
+		if strings.HasPrefix(s, p) {
+			pref = p
+			break
+		}
+	}
+	rest := strings.TrimPrefix(s, pref)
+
+	city := ""
+	for _, c := range irregularCities {
+		if strings.HasPrefix(rest, c) {
+			city = c
+		}
+	}
+	if city == "" {
+		for _, c := range designatedCities {
+			if strings.HasPrefix(rest, c) {
+				if m := wardRegex.FindString(strings.TrimPrefix(rest, c)); m != "" {
+					city = c + m
+				}
+			}
+		}
+	}
+	if city == "" {
+		for _, c := range irregularCountyTowns {
+			if strings.HasPrefix(rest, c) {
+				city = c
+			}
+		}
+	}
+	if city == "" {
+		city = countyTownRegex.FindString(rest)
+	}
+	if city == "" && pref == "東京都" {
+		city = wardRegex.FindString(rest)
+	}
+	if city == "" {
+		city = cityRegex.FindString(rest)
+	}
+	if city == "" {
+		city = townRegex.FindString(rest)
+	}
+	return pref, city, strings.TrimPrefix(rest, city)
+}
@@ -0,0 +1,323 @@
+package csvutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSeparateWithoutColumn(t *testing.T) {
+	s := `aaa,bbb
+山田 太郎,1
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Into:      []string{"last", "first"},
+		Delimiter: " ",
+	}
+
+	if err := Separate(r, w, o); err == nil {
+		t.Fatal("Separate without column should raise error.")
+	}
+}
+
+func TestSeparateWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := `山田 太郎,1
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		NoHeader:  true,
+		Column:    "aaa",
+		Into:      []string{"last", "first"},
+		Delimiter: " ",
+	}
+
+	if err := Separate(r, w, o); err == nil {
+		t.Fatal("Separate with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestSeparateWithoutInto(t *testing.T) {
+	s := `aaa,bbb
+山田 太郎,1
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Column:    "aaa",
+		Delimiter: " ",
+	}
+
+	if err := Separate(r, w, o); err == nil {
+		t.Fatal("Separate without into should raise error.")
+	}
+}
+
+func TestSeparateWithoutDelimiter(t *testing.T) {
+	s := `aaa,bbb
+山田 太郎,1
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Column: "aaa",
+		Into:   []string{"last", "first"},
+	}
+
+	if err := Separate(r, w, o); err == nil {
+		t.Fatal("Separate without delimiter should raise error.")
+	}
+}
+
+func TestSeparateWithBrokenRegexp(t *testing.T) {
+	s := `aaa,bbb
+山田 太郎,1
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Column: "aaa",
+		Into:   []string{"last", "first"},
+		Regexp: "(\\S+",
+	}
+
+	if err := Separate(r, w, o); err == nil {
+		t.Fatal("Separate with broken regexp should raise error.")
+	}
+}
+
+func TestSeparateWithUnmatchedCaptureGroups(t *testing.T) {
+	s := `aaa,bbb
+山田 太郎,1
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Column: "aaa",
+		Into:   []string{"last", "first"},
+		Regexp: "(\\S+)",
+	}
+
+	if err := Separate(r, w, o); err == nil {
+		t.Fatal("Separate with unmatched capture groups should raise error.")
+	}
+}
+
+func TestSeparateWithRegexpAndAddress(t *testing.T) {
+	s := `aaa,bbb
+山田 太郎,1
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Column:  "aaa",
+		Into:    []string{"pref", "city", "town"},
+		Regexp:  "(.)(.)(.)",
+		Address: true,
+	}
+
+	if err := Separate(r, w, o); err == nil {
+		t.Fatal("Separate with regexp and address should raise error.")
+	}
+}
+
+func TestSeparateAddressWithInvalidInto(t *testing.T) {
+	s := `aaa,bbb
+東京都千代田区丸の内1-1,1
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Column:  "aaa",
+		Into:    []string{"pref", "city"},
+		Address: true,
+	}
+
+	if err := Separate(r, w, o); err == nil {
+		t.Fatal("Separate address into not 3 columns should raise error.")
+	}
+}
+
+func TestSeparateWithUnknownColumn(t *testing.T) {
+	s := `aaa,bbb
+山田 太郎,1
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Column:    "ccc",
+		Into:      []string{"last", "first"},
+		Delimiter: " ",
+	}
+
+	if err := Separate(r, w, o); err == nil {
+		t.Fatal("Separate with unknown column should raise error.")
+	}
+}
+
+func TestSeparateWithBrokenCSV(t *testing.T) {
+	s := `aaa,bbb
+山田 太郎,1
+佐藤 花子
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Column:    "aaa",
+		Into:      []string{"last", "first"},
+		Delimiter: " ",
+	}
+
+	if err := Separate(r, w, o); err == nil {
+		t.Fatal("Separate with broken csv should raise error.")
+	}
+}
+
+func TestSeparate(t *testing.T) {
+	s := `id,name,age
+1,山田 太郎,20
+2,佐藤,30
+3,鈴木 一 郎,40
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Column:    "name",
+		Into:      []string{"last", "first"},
+		Delimiter: " ",
+	}
+
+	if err := Separate(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `id,last,first,age
+1,山田,太郎,20
+2,佐藤,,30
+3,鈴木,一 郎,40
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSeparateWithKeep(t *testing.T) {
+	s := `id,name
+1,山田 太郎
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Column:    "name",
+		Into:      []string{"last", "first"},
+		Delimiter: " ",
+		Keep:      true,
+	}
+
+	if err := Separate(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `id,name,last,first
+1,山田 太郎,山田,太郎
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSeparateWithNoHeader(t *testing.T) {
+	s := `1,山田 太郎
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		NoHeader:  true,
+		Column:    "1",
+		Into:      []string{"last", "first"},
+		Delimiter: " ",
+	}
+
+	if err := Separate(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `1,山田,太郎
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSeparateWithRegexp(t *testing.T) {
+	s := `id,zip
+1,〒100-0005
+2,unknown
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Column: "zip",
+		Into:   []string{"zip1", "zip2"},
+		Regexp: `(\d{3})-(\d{4})`,
+	}
+
+	if err := Separate(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `id,zip1,zip2
+1,100,0005
+2,,
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSeparateWithAddress(t *testing.T) {
+	s := `id,address
+1,東京都千代田区丸の内1-1
+2,大阪府大阪市北区梅田1-1
+3,石川県河北郡内灘町大学1-1
+4,三重県四日市市諏訪町1-5
+5,東京都東村山市本町1-2
+6,東京都新宿区市谷本村町5-1
+7,神奈川県横浜市
+8,北海道余市郡余市町黒川町
+9,長野県大町市大町
+10,奈良県大和郡山市北郡山町1
+11,福岡県小郡市大保町1
+12,富山県中新川郡上市町法音寺1
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SeparateOption{
+		Column:  "address",
+		Into:    []string{"pref", "city", "town"},
+		Address: true,
+	}
+
+	if err := Separate(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `id,pref,city,town
+1,東京都,千代田区,丸の内1-1
+2,大阪府,大阪市北区,梅田1-1
+3,石川県,河北郡内灘町,大学1-1
+4,三重県,四日市市,諏訪町1-5
+5,東京都,東村山市,本町1-2
+6,東京都,新宿区,市谷本村町5-1
+7,神奈川県,横浜市,
+8,北海道,余市郡余市町,黒川町
+9,長野県,大町市,大町
+10,奈良県,大和郡山市,北郡山町1
+11,福岡県,小郡市,大保町1
+12,富山県,中新川郡上市町,法音寺1
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
@@ -0,0 +1,225 @@
+package csvutil
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var supportedSequenceModes = []string{"number", "uuid4", "uuid7", "ulid"}
+
+const crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// SequenceOption is option holder for Sequence.
+type SequenceOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// Target column symbol.
+	Column string
+	// Mode of sequence. (number, uuid4, uuid7 or ulid, default number)
+	Mode string
+	// Start number. (number mode only)
+	Start int
+	// Step of number. (number mode only)
+	Step int
+	// Format of number like fmt.Sprintf. (number mode only, default %d)
+	Format string
+	// Seed of random. Timestamp of uuid7 and ulid is also decided by seed. (default random)
+	Seed int64
+}
+
+func (o *SequenceOption) validate() error {
+	if o.Column == "" {
+		return errors.New("no column")
+	}
+	if o.NoHeader {
+		if !isDigit(o.Column) {
+			return errors.New("not number column symbol")
+		}
+	}
+	if o.Mode == "" {
+		o.Mode = "number"
+	}
+	if !containsString(supportedSequenceModes, o.Mode) {
+		return errors.Errorf("unsupported mode: %s", o.Mode)
+	}
+	if o.Mode == "number" && o.Step == 0 {
+		return errors.New("step should not be zero")
+	}
+	if o.Format == "" {
+		o.Format = "%d"
+	}
+	if !strings.Contains(o.Format, "%") {
+		return errors.Errorf("no verb in format: %s", o.Format)
+	}
+	return nil
+}
+
+func (o SequenceOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Sequence overwrite value of given column by sequential numbers or unique IDs.
+func Sequence(r io.Reader, w io.Writer, o SequenceOption) error {
+	opt := &o
+	if err := opt.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, opt.Encoding)
+	cw := writer(w, bom, opt.outputEncoding())
+	defer cw.Flush()
+
+	var col *column
+	csvp := NewCSVProcessor(cr, cw)
+	if opt.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			col = newColumnWithIndex(opt.Column, nil)
+			return col.err
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			col = newColumnWithIndex(opt.Column, hdr)
+			return hdr, col.err
+		})
+	}
+	seq := newSequencer(*opt)
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		s, err := seq.next()
+		if err != nil {
+			return nil, err
+		}
+		rec[col.index] = s
+		return rec, nil
+	})
+
+	return csvp.Process()
+}
+
+type sequencer struct {
+	opt   SequenceOption
+	rand  *rand.Rand
+	n     int
+	count int64
+	base  time.Time
+	seen  map[string]struct{}
+}
+
+func newSequencer(o SequenceOption) *sequencer {
+	r := newRand(o.Seed)
+	base := time.Now()
+	if o.Seed != 0 {
+		start := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		base = start.Add(time.Duration(r.Int63n(int64(30*365*24*time.Hour/time.Millisecond))) * time.Millisecond)
+	}
+	return &sequencer{
+		opt:  o,
+		rand: r,
+		n:    o.Start,
+		base: base,
+		seen: make(map[string]struct{}),
+	}
+}
+
+// next returns next value that is unique in this sequencer.
+func (s *sequencer) next() (string, error) {
+	if s.opt.Mode == "number" {
+		v := fmt.Sprintf(s.opt.Format, s.n)
+		s.n += s.opt.Step
+		if _, ok := s.seen[v]; ok {
+			return "", errors.Errorf("duplicated value: %s", v)
+		}
+		s.seen[v] = struct{}{}
+		return v, nil
+	}
+
+	for {
+		var v string
+		switch s.opt.Mode {
+		case "uuid4":
+			v = s.uuid4()
+		case "uuid7":
+			v = s.uuid7()
+		default:
+			v = s.ulid()
+		}
+		if _, ok := s.seen[v]; !ok {
+			s.seen[v] = struct{}{}
+			return v, nil
+		}
+	}
+}
+
+// millis returns unix time milliseconds for current row.
+// When seed is given, it is advanced 1 millisecond per row for reproducibility.
+func (s *sequencer) millis() uint64 {
+	s.count++
+	if s.opt.Seed != 0 {
+		return uint64(s.base.UnixNano()/int64(time.Millisecond) + s.count)
+	}
+	return uint64(time.Now().UnixNano() / int64(time.Millisecond))
+}
+
+func (s *sequencer) uuid4() string {
+	b := make([]byte, 16)
+	s.rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return formatUUID(b)
+}
+
+func (s *sequencer) uuid7() string {
+	b := make([]byte, 16)
+	s.rand.Read(b)
+	ms := s.millis()
+	for i := 0; i < 6; i++ {
+		b[i] = byte(ms >> uint(8*(5-i)))
+	}
+	b[6] = (b[6] & 0x0f) | 0x70
+	b[8] = (b[8] & 0x3f) | 0x80
+	return formatUUID(b)
+}
+
+func formatUUID(b []byte) string {
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+func (s *sequencer) ulid() string {
+	b := make([]byte, 16)
+	s.rand.Read(b[6:])
+	ms := s.millis()
+	for i := 0; i < 6; i++ {
+		b[i] = byte(ms >> uint(8*(5-i)))
+	}
+	return encodeCrockford(b)
+}
+
+// encodeCrockford encodes 128 bits into 26 characters of Crockford's base32.
+func encodeCrockford(b []byte) string {
+	dst := make([]byte, 26)
+	// 2 bits padding are needed because 26 characters have 130 bits.
+	var acc uint
+	bits := uint(2)
+	j := 0
+	for _, c := range b {
+		acc = acc<<8 | uint(c)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			dst[j] = crockfordBase32[(acc>>bits)&0x1f]
+			j++
+		}
+	}
+	return string(dst)
+}
@@ -0,0 +1,217 @@
+package csvutil
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+)
+
+func TestSequenceWithoutColumn(t *testing.T) {
+	s := `id,name
+,x
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SequenceOption{
+		Step: 1,
+	}
+
+	if err := Sequence(r, w, o); err == nil {
+		t.Fatal("Sequence without column should raise error.")
+	}
+}
+
+func TestSequenceWithNoHeaderButColumnNotNumber(t *testing.T) {
+	r := bytes.NewBufferString(",x\n")
+	w := &bytes.Buffer{}
+	o := SequenceOption{
+		NoHeader: true,
+		Column:   "id",
+		Step:     1,
+	}
+
+	if err := Sequence(r, w, o); err == nil {
+		t.Fatal("Sequence with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestSequenceWithUnsupportedMode(t *testing.T) {
+	s := `id,name
+,x
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SequenceOption{
+		Column: "id",
+		Mode:   "uuid1",
+	}
+
+	if err := Sequence(r, w, o); err == nil {
+		t.Fatal("Sequence with unsupported mode should raise error.")
+	}
+}
+
+func TestSequenceWithZeroStep(t *testing.T) {
+	s := `id,name
+,x
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SequenceOption{
+		Column: "id",
+	}
+
+	if err := Sequence(r, w, o); err == nil {
+		t.Fatal("Sequence with zero step should raise error.")
+	}
+}
+
+func TestSequenceWithFormatWithoutVerb(t *testing.T) {
+	s := `id,name
+,x
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SequenceOption{
+		Column: "id",
+		Step:   1,
+		Format: "C",
+	}
+
+	if err := Sequence(r, w, o); err == nil {
+		t.Fatal("Sequence with format without verb should raise error.")
+	}
+}
+
+func TestSequenceWithDuplicatedValue(t *testing.T) {
+	s := `id,name
+,x
+,x
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SequenceOption{
+		Column: "id",
+		Step:   1,
+		Format: "C%[2]d",
+	}
+
+	if err := Sequence(r, w, o); err == nil {
+		t.Fatal("Sequence that generates duplicated value should raise error.")
+	}
+}
+
+func TestSequence(t *testing.T) {
+	s := `id,name
+,x
+,x
+,x
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SequenceOption{
+		Column: "id",
+		Start:  10,
+		Step:   -5,
+		Format: "C%06d",
+	}
+
+	if err := Sequence(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `id,name
+C000010,x
+C000005,x
+C000000,x
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSequenceWithNoHeader(t *testing.T) {
+	r := bytes.NewBufferString("a,\nb,\n")
+	w := &bytes.Buffer{}
+	o := SequenceOption{
+		NoHeader: true,
+		Column:   "1",
+		Start:    1,
+		Step:     1,
+	}
+
+	if err := Sequence(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `a,1
+b,2
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSequenceWithIDModes(t *testing.T) {
+	tests := []struct {
+		mode  string
+		regex *regexp.Regexp
+	}{
+		{"uuid4", regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)},
+		{"uuid7", regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)},
+		{"ulid", regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Z]{25}$`)},
+	}
+	for _, tt := range tests {
+		r := bytes.NewBufferString(generateCSV("id,name", 100, func(int) string { return ",x" }))
+		w := &bytes.Buffer{}
+		o := SequenceOption{
+			Column: "id",
+			Mode:   tt.mode,
+		}
+
+		if err := Sequence(r, w, o); err != nil {
+			t.Fatal(err)
+		}
+
+		seen := make(map[string]bool)
+		for _, rec := range readCSV(w.String())[1:] {
+			if !tt.regex.MatchString(rec[0]) {
+				t.Errorf("%s is invalid %s", rec[0], tt.mode)
+			}
+			if seen[rec[0]] {
+				t.Errorf("%s is duplicated", rec[0])
+			}
+			seen[rec[0]] = true
+		}
+	}
+}
+
+func TestSequenceWithSeed(t *testing.T) {
+	for _, mode := range []string{"uuid4", "uuid7", "ulid"} {
+		var results []string
+		for i := 0; i < 2; i++ {
+			r := bytes.NewBufferString(generateCSV("id,name", 10, func(int) string { return ",x" }))
+			w := &bytes.Buffer{}
+			o := SequenceOption{
+				Column: "id",
+				Mode:   mode,
+				Seed:   42,
+			}
+			if err := Sequence(r, w, o); err != nil {
+				t.Fatal(err)
+			}
+			results = append(results, w.String())
+		}
+		if results[0] != results[1] {
+			t.Errorf("%s with same seed should be reproducible.", mode)
+		}
+	}
+}
+
+func TestEncodeCrockford(t *testing.T) {
+	b := []byte{0x01, 0x56, 0x3d, 0xf3, 0x64, 0x81, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	expected := "01ARYZ6S410000000000000000"
+	if actual := encodeCrockford(b); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
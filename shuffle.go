@@ -0,0 +1,181 @@
+package csvutil
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultShuffleMemoryLimit = 64 * 1024 * 1024
+	shuffleBucketSize         = 16
+)
+
+// ShuffleOption is option holder for Shuffle.
+type ShuffleOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// Seed for random. (0 means random seed)
+	Seed int64
+	// MemoryLimit is bytes of lines kept in memory. (default 64MB)
+	// When source is larger than this, lines are shuffled with temporary files.
+	MemoryLimit int
+}
+
+func (o ShuffleOption) validate() error {
+	if o.MemoryLimit < 0 {
+		return errors.New("negative memory limit")
+	}
+	return nil
+}
+
+func (o ShuffleOption) memoryLimit() int {
+	if o.MemoryLimit == 0 {
+		return defaultShuffleMemoryLimit
+	}
+	return o.MemoryLimit
+}
+
+func (o ShuffleOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Shuffle lines of CSV.
+// Header line is kept at the top.
+func Shuffle(r io.Reader, w io.Writer, o ShuffleOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	if !o.NoHeader {
+		hdr, err := cr.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		cw.Write(hdr)
+	}
+
+	s := &shuffler{
+		rnd:   newRand(o.Seed),
+		limit: o.memoryLimit(),
+		w:     cw,
+	}
+	return s.shuffle(cr.Read)
+}
+
+type shuffler struct {
+	rnd   *rand.Rand
+	limit int
+	w     *csv.Writer
+}
+
+func (s *shuffler) shuffle(read func() ([]string, error)) error {
+	var recs [][]string
+	size := 0
+	for {
+		rec, err := read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		recs = append(recs, rec)
+		size += recordSize(rec)
+		if size > s.limit && len(recs) > 1 {
+			return s.shuffleOnDisk(recs, read)
+		}
+	}
+
+	shuffleRecords(s.rnd, recs)
+	for _, rec := range recs {
+		s.w.Write(rec)
+	}
+	return nil
+}
+
+// shuffleOnDisk distributes lines to temporary files at random, and shuffles each file.
+func (s *shuffler) shuffleOnDisk(recs [][]string, read func() ([]string, error)) error {
+	files := make([]*os.File, shuffleBucketSize)
+	writers := make([]*csv.Writer, shuffleBucketSize)
+	defer func() {
+		for _, f := range files {
+			if f != nil {
+				f.Close()
+				os.Remove(f.Name())
+			}
+		}
+	}()
+	for i := range files {
+		f, err := ioutil.TempFile("", "csvutil")
+		if err != nil {
+			return errors.Wrap(err, "failed create tempfile")
+		}
+		files[i] = f
+		writers[i] = csv.NewWriter(f)
+	}
+
+	for _, rec := range recs {
+		writers[s.rnd.Intn(shuffleBucketSize)].Write(rec)
+	}
+	for {
+		rec, err := read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		writers[s.rnd.Intn(shuffleBucketSize)].Write(rec)
+	}
+
+	for i, f := range files {
+		writers[i].Flush()
+		if err := writers[i].Error(); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		br := csv.NewReader(bufio.NewReader(f))
+		br.FieldsPerRecord = -1
+		if err := s.shuffle(br.Read); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func shuffleRecords(rnd *rand.Rand, recs [][]string) {
+	for i := len(recs) - 1; i > 0; i-- {
+		j := rnd.Intn(i + 1)
+		recs[i], recs[j] = recs[j], recs[i]
+	}
+}
+
+func recordSize(rec []string) int {
+	size := len(rec)
+	for _, s := range rec {
+		size += len(s)
+	}
+	return size
+}
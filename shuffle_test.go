@@ -0,0 +1,176 @@
+package csvutil
+
+import (
+	"bytes"
+	"io/ioutil"
+	"sort"
+	"testing"
+)
+
+func BenchmarkShuffle(b *testing.B) {
+	p, err := ioutil.ReadFile("testdata/bench.csv")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < b.N; i++ {
+		r := bytes.NewBuffer(p)
+		w := &bytes.Buffer{}
+		o := ShuffleOption{}
+		Shuffle(r, w, o)
+	}
+}
+
+func sortedIDs(data [][]string) []string {
+	ids := make([]string, len(data))
+	for i, rec := range data {
+		ids[i] = rec[0]
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestShuffleWithNegativeMemoryLimit(t *testing.T) {
+	s := `id,group
+1,a
+2,a
+3,a
+4,b
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := ShuffleOption{
+		MemoryLimit: -1,
+	}
+
+	if err := Shuffle(r, w, o); err == nil {
+		t.Fatal("Shuffle with negative memory limit should raise error.")
+	}
+}
+
+func TestShuffleWithBrokenCSV(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5
+7,8,9
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := ShuffleOption{}
+
+	if err := Shuffle(r, w, o); err == nil {
+		t.Fatal("Shuffle with broken csv should raise error.")
+	}
+}
+
+func TestShuffleWithEmptyCSV(t *testing.T) {
+	r := bytes.NewBufferString("")
+	w := &bytes.Buffer{}
+	o := ShuffleOption{}
+
+	if err := Shuffle(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	if w.String() != "" {
+		t.Fatalf("Expected empty, but got %s", w.String())
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	s := generateCSV("id,group", 100, sampleLine)
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := ShuffleOption{}
+
+	if err := Shuffle(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	src := readCSV(s)
+	actual := readCSV(w.String())
+	if actual[0][0] != "id" || actual[0][1] != "group" {
+		t.Fatalf("Header should be kept: %v", actual[0])
+	}
+	if len(actual) != len(src) {
+		t.Fatalf("Shuffle should output all lines: %d", len(actual))
+	}
+	if w.String() == s {
+		t.Fatal("Shuffle should change order of lines.")
+	}
+	expected := sortedIDs(src[1:])
+	for i, id := range sortedIDs(actual[1:]) {
+		if id != expected[i] {
+			t.Fatalf("Shuffle should output same lines: %v", actual)
+		}
+	}
+}
+
+func TestShuffleWithNoHeader(t *testing.T) {
+	s := generateCSV("id,group", 100, sampleLine)
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := ShuffleOption{
+		NoHeader: true,
+	}
+
+	if err := Shuffle(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	actual := readCSV(w.String())
+	if len(actual) != 101 {
+		t.Fatalf("Shuffle should output all lines: %d", len(actual))
+	}
+	found := false
+	for _, rec := range actual {
+		if rec[0] == "id" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("Shuffle with no header should treat first line as body line.")
+	}
+}
+
+func TestShuffleWithSeed(t *testing.T) {
+	s := generateCSV("id,group", 100, sampleLine)
+	w1 := &bytes.Buffer{}
+	w2 := &bytes.Buffer{}
+	o := ShuffleOption{
+		Seed: 42,
+	}
+
+	if err := Shuffle(bytes.NewBufferString(s), w1, o); err != nil {
+		t.Fatal(err)
+	}
+	if err := Shuffle(bytes.NewBufferString(s), w2, o); err != nil {
+		t.Fatal(err)
+	}
+	if w1.String() != w2.String() {
+		t.Fatalf("Shuffle with same seed should output same lines: %s, %s", w1.String(), w2.String())
+	}
+}
+
+func TestShuffleWithSmallMemoryLimit(t *testing.T) {
+	s := generateCSV("id,group", 1000, sampleLine)
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := ShuffleOption{
+		MemoryLimit: 100,
+	}
+
+	if err := Shuffle(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	src := readCSV(s)
+	actual := readCSV(w.String())
+	if len(actual) != len(src) {
+		t.Fatalf("Shuffle should output all lines: %d", len(actual))
+	}
+	expected := sortedIDs(src[1:])
+	for i, id := range sortedIDs(actual[1:]) {
+		if id != expected[i] {
+			t.Fatalf("Shuffle should output same lines: %v", actual)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package csvutil
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// SliceOption is option holder for Slice.
+type SliceOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// From is first line number of range. (1 origin, header is not counted)
+	From int
+	// To is last line number of range. (0 means last line)
+	To int
+}
+
+func (o SliceOption) validate() error {
+	if o.From <= 0 {
+		return errors.New("negative or zero from")
+	}
+	if o.To < 0 {
+		return errors.New("negative to")
+	}
+	if o.To != 0 && o.To < o.From {
+		return errors.New("to should be greater than or equal to from")
+	}
+	return nil
+}
+
+func (o SliceOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Slice reads lines in given range.
+func Slice(r io.Reader, w io.Writer, o SliceOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	if !o.NoHeader {
+		hdr, err := cr.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		cw.Write(hdr)
+	}
+
+	for i := 1; o.To == 0 || i <= o.To; i++ {
+		rec, err := cr.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		if i >= o.From {
+			cw.Write(rec)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package csvutil
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+func BenchmarkSlice(b *testing.B) {
+	p, err := ioutil.ReadFile("testdata/bench.csv")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < b.N; i++ {
+		r := bytes.NewBuffer(p)
+		w := &bytes.Buffer{}
+		o := SliceOption{
+			From: 100,
+			To:   200,
+		}
+		Slice(r, w, o)
+	}
+}
+
+func TestSliceWithZeroFrom(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+7,8,9
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SliceOption{}
+
+	if err := Slice(r, w, o); err == nil {
+		t.Fatal("Slice with zero from should raise error.")
+	}
+}
+
+func TestSliceWithNegativeTo(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+7,8,9
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SliceOption{
+		From: 1,
+		To:   -1,
+	}
+
+	if err := Slice(r, w, o); err == nil {
+		t.Fatal("Slice with negative to should raise error.")
+	}
+}
+
+func TestSliceWithToLessThanFrom(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+7,8,9
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SliceOption{
+		From: 3,
+		To:   2,
+	}
+
+	if err := Slice(r, w, o); err == nil {
+		t.Fatal("Slice with to less than from should raise error.")
+	}
+}
+
+func TestSliceWithBrokenCSV(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5
+7,8,9
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SliceOption{
+		From: 1,
+		To:   3,
+	}
+
+	if err := Slice(r, w, o); err == nil {
+		t.Fatal("Slice with broken csv should raise error.")
+	}
+}
+
+func TestSliceWithEmptyCSV(t *testing.T) {
+	r := bytes.NewBufferString("")
+	w := &bytes.Buffer{}
+	o := SliceOption{
+		From: 1,
+	}
+
+	if err := Slice(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	if w.String() != "" {
+		t.Fatalf("Expected empty, but got %s", w.String())
+	}
+}
+
+func TestSlice(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+7,8,9
+10,11,12
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SliceOption{
+		From: 2,
+		To:   3,
+	}
+
+	if err := Slice(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+4,5,6
+7,8,9
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSliceWithoutTo(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+7,8,9
+10,11,12
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SliceOption{
+		From: 3,
+	}
+
+	if err := Slice(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+7,8,9
+10,11,12
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSliceWithNoHeader(t *testing.T) {
+	s := `1,2,3
+4,5,6
+7,8,9
+10,11,12
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SliceOption{
+		NoHeader: true,
+		From:     1,
+		To:       2,
+	}
+
+	if err := Slice(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `1,2,3
+4,5,6
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/pkg/errors"
 )
 
+var supportedSubstituteFallbacks = []string{"keep", "empty", "error"}
+
 // SubstituteOption is option holder for Substitute.
 type SubstituteOption struct {
 	// Source file does not have header line. (default false)
@@ -17,45 +19,110 @@ type SubstituteOption struct {
 	// Encoding for output
 	OutputEncoding string
 	// Target column symbol
+	//
+	// Deprecated: Use ColumnSyms instead.
 	Column string
+	// ColumnSyms header or column index list.
+	ColumnSyms []string
+	// Target all columns.
+	AllColumns bool
 	// Target pattern
 	Pattern string
 	// Replacement value
 	Replacement string
 	// Use regexp
-	Regexp  bool
-	regex   *regexp.Regexp
-	subFunc func(string) string
+	Regexp bool
+	// Ignore case on matching.
+	IgnoreCase bool
+	// Mapping is pairs of old value and new value. (dictionary mode)
+	Mapping map[string]string
+	// Fallback for unmapped value on dictionary mode. (keep, empty or error, default keep)
+	Fallback string
+	regex    *regexp.Regexp
+	subFunc  func(string) (string, error)
 }
 
 func (o *SubstituteOption) validate() error {
-	if o.Column == "" {
+	if o.Column != "" && !containsString(o.ColumnSyms, o.Column) {
+		o.ColumnSyms = append(o.ColumnSyms, o.Column)
+	}
+	if len(o.ColumnSyms) == 0 && !o.AllColumns {
 		return errors.New("no column")
 	}
-	if o.Pattern == "" {
+	if len(o.ColumnSyms) != 0 && o.AllColumns {
+		return errors.New("column and all columns cannot be used together")
+	}
+	if o.Pattern == "" && len(o.Mapping) == 0 {
 		return errors.New("no pattern")
 	}
+	if o.Pattern != "" && len(o.Mapping) != 0 {
+		return errors.New("pattern and mapping cannot be used together")
+	}
 	if o.NoHeader {
-		if !isDigit(o.Column) {
-			return errors.New("not number column symbol")
+		for _, c := range o.ColumnSyms {
+			if !isDigit(c) {
+				return errors.New("not number column symbol")
+			}
+		}
+	}
+	if o.Fallback != "" && !containsString(supportedSubstituteFallbacks, o.Fallback) {
+		return errors.Errorf("unsupported fallback: %s", o.Fallback)
+	}
+	if len(o.Mapping) != 0 {
+		o.subFunc = o.mappingFunc()
+		return nil
+	}
+	if !o.Regexp && !o.IgnoreCase {
+		o.subFunc = func(s string) (string, error) {
+			return strings.Replace(s, o.Pattern, o.Replacement, -1), nil
+		}
+		return nil
+	}
+
+	p := o.Pattern
+	rep := o.Replacement
+	if !o.Regexp {
+		p = regexp.QuoteMeta(p)
+		rep = strings.Replace(rep, "$", "$$", -1)
+	}
+	if o.IgnoreCase {
+		p = "(?i)" + p
+	}
+	r, err := regexp.Compile(p)
+	if err != nil {
+		return err
+	}
+	o.regex = r
+	o.subFunc = func(s string) (string, error) {
+		return o.regex.ReplaceAllString(s, rep), nil
+	}
+	return nil
+}
 
+func (o *SubstituteOption) mappingFunc() func(string) (string, error) {
+	m := o.Mapping
+	if o.IgnoreCase {
+		m = make(map[string]string, len(o.Mapping))
+		for k, v := range o.Mapping {
+			m[strings.ToLower(k)] = v
 		}
 	}
-	if o.Regexp {
-		r, err := regexp.Compile(o.Pattern)
-		if err != nil {
-			return err
+	return func(s string) (string, error) {
+		k := s
+		if o.IgnoreCase {
+			k = strings.ToLower(s)
 		}
-		o.regex = r
-		o.subFunc = func(s string) string {
-			return o.regex.ReplaceAllString(s, o.Replacement)
+		if v, ok := m[k]; ok {
+			return v, nil
 		}
-	} else {
-		o.subFunc = func(s string) string {
-			return strings.Replace(s, o.Pattern, o.Replacement, -1)
+		switch o.Fallback {
+		case "empty":
+			return "", nil
+		case "error":
+			return "", errors.Errorf("unmapped value: %s", s)
 		}
+		return s, nil
 	}
-	return nil
 }
 
 func (o SubstituteOption) outputEncoding() string {
@@ -65,7 +132,7 @@ func (o SubstituteOption) outputEncoding() string {
 	return o.Encoding
 }
 
-// Substitute value of given column.
+// Substitute value of given columns.
 func Substitute(r io.Reader, w io.Writer, o SubstituteOption) error {
 	opt := &o
 	if err := opt.validate(); err != nil {
@@ -76,23 +143,57 @@ func Substitute(r io.Reader, w io.Writer, o SubstituteOption) error {
 	cw := writer(w, bom, opt.outputEncoding())
 	defer cw.Flush()
 
-	var col *column
+	var cols columns
 	csvp := NewCSVProcessor(cr, cw)
 	if o.NoHeader {
 		csvp.SetPreBodyRead(func() error {
-			col = newColumnWithIndex(opt.Column, nil)
-			return col.err
+			cols = newUniqueColumns(opt.ColumnSyms, nil)
+			return cols.err()
 		})
 	} else {
 		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
-			col = newColumnWithIndex(opt.Column, hdr)
-			return hdr, col.err
+			cols = newUniqueColumns(opt.ColumnSyms, hdr)
+			return hdr, cols.err()
 		})
 	}
 	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
-		rec[col.index] = opt.subFunc(rec[col.index])
+		if opt.AllColumns {
+			for i := range rec {
+				s, err := opt.subFunc(rec[i])
+				if err != nil {
+					return nil, err
+				}
+				rec[i] = s
+			}
+			return rec, nil
+		}
+		for _, col := range cols {
+			s, err := opt.subFunc(rec[col.index])
+			if err != nil {
+				return nil, err
+			}
+			rec[col.index] = s
+		}
 		return rec, nil
 	})
 
 	return csvp.Process()
 }
+
+// ReadMapping reads pairs of old value and new value from CSV that does not have header line.
+func ReadMapping(r io.Reader, enc string) (map[string]string, error) {
+	cr, _ := reader(r, enc)
+	cr.FieldsPerRecord = -1
+	recs, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]string, len(recs))
+	for _, rec := range recs {
+		if len(rec) < 2 {
+			return nil, errors.Errorf("invalid mapping: %s", strings.Join(rec, ","))
+		}
+		m[rec[0]] = rec[1]
+	}
+	return m, nil
+}
@@ -254,3 +254,262 @@ z8z,8,9
 		}
 	}
 }
+
+func TestSubstituteWithColumnAndAllColumns(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SubstituteOption{
+		ColumnSyms: []string{"aaa"},
+		AllColumns: true,
+		Pattern:    "1",
+	}
+
+	if err := Substitute(r, w, o); err == nil {
+		t.Fatal("Substitute with both column and all columns should raise error.")
+	}
+}
+
+func TestSubstituteWithPatternAndMapping(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SubstituteOption{
+		ColumnSyms: []string{"aaa"},
+		Pattern:    "1",
+		Mapping:    map[string]string{"1": "one"},
+	}
+
+	if err := Substitute(r, w, o); err == nil {
+		t.Fatal("Substitute with both pattern and mapping should raise error.")
+	}
+}
+
+func TestSubstituteWithUnsupportedFallback(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SubstituteOption{
+		ColumnSyms: []string{"aaa"},
+		Mapping:    map[string]string{"1": "one"},
+		Fallback:   "foo",
+	}
+
+	if err := Substitute(r, w, o); err == nil {
+		t.Fatal("Substitute with unsupported fallback should raise error.")
+	}
+}
+
+func TestSubstituteWithMultipleColumns(t *testing.T) {
+	s := `aaa,bbb,ccc
+x4x,4,4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SubstituteOption{
+		ColumnSyms:  []string{"aaa", "ccc"},
+		Pattern:     "4",
+		Replacement: "@",
+	}
+
+	if err := Substitute(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+x@x,4,@
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSubstituteWithColumnAndColumnSyms(t *testing.T) {
+	s := `aaa,bbb,ccc
+x4x,4,4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SubstituteOption{
+		Column:      "bbb",
+		ColumnSyms:  []string{"aaa", "bbb"},
+		Pattern:     "4",
+		Replacement: "@",
+	}
+
+	if err := Substitute(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb,ccc
+x@x,@,4
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSubstituteWithAllColumns(t *testing.T) {
+	s := `a-a,b-b,c-c
+x-x,y-y,z-z
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SubstituteOption{
+		AllColumns:  true,
+		Pattern:     "-",
+		Replacement: "_",
+	}
+
+	if err := Substitute(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `a-a,b-b,c-c
+x_x,y_y,z_z
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSubstituteWithIgnoreCase(t *testing.T) {
+	s := `aaa
+Foo.foo.FOO
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SubstituteOption{
+		ColumnSyms:  []string{"aaa"},
+		Pattern:     "foo.",
+		Replacement: "$1",
+		IgnoreCase:  true,
+	}
+
+	if err := Substitute(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa
+$1$1FOO
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSubstituteWithCaptureGroup(t *testing.T) {
+	s := `aaa
+2018-04-01
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SubstituteOption{
+		ColumnSyms:  []string{"aaa"},
+		Pattern:     `(\d+)-(\d+)-(\d+)`,
+		Replacement: "${1}/${2}/${3}",
+		Regexp:      true,
+	}
+
+	if err := Substitute(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa
+2018/04/01
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestSubstituteWithMapping(t *testing.T) {
+	s := `code,name
+a,x
+B,y
+c,z
+`
+	m := map[string]string{"a": "Alpha", "b": "Bravo"}
+	tests := []struct {
+		fallback   string
+		ignoreCase bool
+		expected   string
+	}{
+		{"", false, "code,name\nAlpha,x\nB,y\nc,z\n"},
+		{"keep", true, "code,name\nAlpha,x\nBravo,y\nc,z\n"},
+		{"empty", true, "code,name\nAlpha,x\nBravo,y\n,z\n"},
+	}
+	for _, tt := range tests {
+		r := bytes.NewBufferString(s)
+		w := &bytes.Buffer{}
+		o := SubstituteOption{
+			ColumnSyms: []string{"code"},
+			Mapping:    m,
+			Fallback:   tt.fallback,
+			IgnoreCase: tt.ignoreCase,
+		}
+
+		if err := Substitute(r, w, o); err != nil {
+			t.Fatal(err)
+		}
+		if actual := w.String(); actual != tt.expected {
+			t.Errorf("Expected: %s, but got %s", tt.expected, actual)
+		}
+	}
+}
+
+func TestSubstituteWithMappingAndErrorFallback(t *testing.T) {
+	s := `code,name
+a,x
+c,z
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := SubstituteOption{
+		ColumnSyms: []string{"code"},
+		Mapping:    map[string]string{"a": "Alpha"},
+		Fallback:   "error",
+	}
+
+	if err := Substitute(r, w, o); err == nil {
+		t.Fatal("Substitute with unmapped value and error fallback should raise error.")
+	}
+}
+
+func TestReadMapping(t *testing.T) {
+	s := "\xEF\xBB\xBFaaa,xxx\nbbb,yyy\n"
+	m, err := ReadMapping(bytes.NewBufferString(s), "utf8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m) != 2 || m["aaa"] != "xxx" || m["bbb"] != "yyy" {
+		t.Fatalf("Invalid mapping: %v", m)
+	}
+}
+
+func TestReadMappingWithShiftJIS(t *testing.T) {
+	p, err := ioutil.ReadFile("testdata/sjis.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := ReadMapping(bytes.NewBuffer(p), "sjis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m["名前"] != "個数" {
+		t.Fatalf("Invalid mapping: %v", m)
+	}
+}
+
+func TestReadMappingWithInvalidLine(t *testing.T) {
+	s := "aaa,xxx\nbbb\n"
+	if _, err := ReadMapping(bytes.NewBufferString(s), "utf8"); err == nil {
+		t.Fatal("ReadMapping with line that has only one value should raise error.")
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -27,6 +29,10 @@ type TelOption struct {
 	Column string
 	// Rate of output mobile tel number.
 	MobileRate int
+	// Reference address column symbol for area code.
+	Reference string
+	// Output fictional tel number that is never assigned.
+	Fictional bool
 }
 
 func (o TelOption) validate() error {
@@ -38,10 +44,16 @@ func (o TelOption) validate() error {
 			return errors.New("not number column symbol")
 
 		}
+		if !isEmptyOrDigit(o.Reference) {
+			return errors.New("not number reference column symbol")
+		}
 	}
 	if o.MobileRate < 0 || 100 < o.MobileRate {
 		return errors.New("invalid mobile rate (0 <= rate <= 100)")
 	}
+	if o.Fictional && o.MobileRate > 0 {
+		return errors.New("mobile tel number is not available with fictional")
+	}
 	return nil
 }
 
@@ -62,37 +74,82 @@ func Tel(r io.Reader, w io.Writer, o TelOption) error {
 	cw := writer(w, bom, o.outputEncoding())
 	defer cw.Flush()
 
-	var col *column
+	var col, ref *column
+	setup := func(hdr []string) error {
+		col = newColumnWithIndex(o.Column, hdr)
+		ref = newColumnWithIndex(o.Reference, hdr)
+		return columns{col, ref}.err()
+	}
 	csvp := NewCSVProcessor(cr, cw)
 	if o.NoHeader {
 		csvp.SetPreBodyRead(func() error {
-			col = newColumnWithIndex(o.Column, nil)
-			return col.err
+			return setup(nil)
 		})
 	} else {
 		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
-			col = newColumnWithIndex(o.Column, hdr)
-			return hdr, col.err
+			return hdr, setup(hdr)
 		})
 	}
 	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
 		if lot(o.MobileRate) {
 			rec[col.index] = fakeMobileTel()
-		} else {
-			rec[col.index] = fakeTel()
+			return rec, nil
+		}
+		var acs []areaCode
+		if ref.index != -1 {
+			acs = findAreaCodes(rec[ref.index])
 		}
+		if len(acs) == 0 {
+			acs = areaCodes
+		}
+		rec[col.index] = fakeTel(acs[rand.Intn(len(acs))].code, o.Fictional)
 		return rec, nil
 	})
 
 	return csvp.Process()
 }
 
-func fakeTel() string {
-	var ac string
-	for ac == "" || containsString(mobileTelAreaCodes, ac) {
-		ac = fmt.Sprintf("0%d", rand.Intn(99)+1)
+// fakeTel returns landline tel number that has 10 digits.
+// Local exchange number is 6 digits minus length of area code.
+// Local exchange number never starts with 0 or 1, so fictional number uses 0 as first digit.
+func fakeTel(ac string, fictional bool) string {
+	first := rand.Intn(8) + 2
+	if fictional {
+		first = 0
+	}
+	local := strconv.Itoa(first) + fakeDigits(6-len(ac)-1)
+	return fmt.Sprintf("%s-%s-%04d", ac, local, rand.Intn(10000))
+}
+
+// findAreaCodes returns area codes that match prefecture and city in address.
+// Address can be prefecture code.
+func findAreaCodes(addr string) []areaCode {
+	if len(addr) == 2 && isDigit(addr) {
+		if n, _ := strconv.Atoi(addr); 1 <= n && n <= len(prefs) {
+			addr = prefs[n-1]
+		}
+	}
+	acs := areaCodes
+	for _, p := range prefs {
+		if strings.Contains(addr, p) {
+			acs = nil
+			for _, ac := range areaCodes {
+				if ac.prefecture == p {
+					acs = append(acs, ac)
+				}
+			}
+			break
+		}
+	}
+	for _, ac := range acs {
+		if ac.city != "" && strings.Contains(addr, ac.city) {
+			return []areaCode{ac}
+		}
+	}
+	if len(acs) == len(areaCodes) {
+		return nil
 	}
-	return fmt.Sprintf("%s-%04d-%04d", ac, rand.Intn(10000), rand.Intn(10000))
+	return acs
 }
 
 func fakeMobileTel() string {
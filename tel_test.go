@@ -197,6 +197,130 @@ func TestTelMobile(t *testing.T) {
 	}
 }
 
+func TestTelWithNoHeaderButReferenceNotNumber(t *testing.T) {
+	s := `1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TelOption{
+		NoHeader:  true,
+		Column:    "0",
+		Reference: "foo",
+	}
+
+	if err := Tel(r, w, o); err == nil {
+		t.Fatal("Tel with not number reference column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestTelWithFictionalAndMobileRate(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TelOption{
+		Column:     "aaa",
+		MobileRate: 10,
+		Fictional:  true,
+	}
+
+	if err := Tel(r, w, o); err == nil {
+		t.Fatal("Tel with fictional and mobile rate should raise error.")
+	}
+}
+
+func TestTelWithReference(t *testing.T) {
+	s := `住所,電話番号
+東京都千代田区丸の内1-1,
+大阪府大阪市北区梅田1-1,
+鹿児島県薩摩川内市神田町1-1,
+北海道札幌市中央区北一条西1-1,
+13,
+不明,
+東京都町田市森野2-2-22,
+東京都三鷹市野崎1-1-1,
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TelOption{
+		Column:    "電話番号",
+		Reference: "住所",
+	}
+
+	if err := Tel(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	data := readCSV(w.String())
+	rgxs := []*regexp.Regexp{
+		regexp.MustCompile(`^03-[2-9]\d{3}-\d{4}$`),
+		regexp.MustCompile(`^06-[2-9]\d{3}-\d{4}$`),
+		regexp.MustCompile(`^0996-[2-9]\d-\d{4}$`),
+		regexp.MustCompile(`^011-[2-9]\d{2}-\d{4}$`),
+		regexp.MustCompile(`^0(3|42|422|428|4992|4994|4996|4998)-[2-9]\d{0,3}-\d{4}$`),
+		regexp.MustCompile(`^0\d{1,4}-[2-9]\d{0,3}-\d{4}$`),
+		regexp.MustCompile(`^042-[2-9]\d{2}-\d{4}$`),
+		regexp.MustCompile(`^0422-[2-9]\d-\d{4}$`),
+	}
+	for i, rgx := range rgxs {
+		if !rgx.MatchString(data[i+1][1]) {
+			t.Errorf("Invalid tel number for %s: %s", data[i+1][0], data[i+1][1])
+		}
+	}
+}
+
+func TestTelWithFictional(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+7,8,9
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TelOption{
+		Column:    "aaa",
+		Fictional: true,
+	}
+
+	if err := Tel(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	data := readCSV(w.String())
+	rgx := regexp.MustCompile(`^0\d{1,4}-0\d{0,3}-\d{4}$`)
+	if ok := allOK(data, 0, rgx.MatchString); !ok {
+		t.Fatalf("Tel failed updating on fictional tel number. %+v", data)
+	}
+}
+
+func TestFakeTel(t *testing.T) {
+	for _, ac := range areaCodes {
+		tel := fakeTel(ac.code, false)
+		if len(strings.Replace(tel, "-", "", -1)) != 10 {
+			t.Errorf("Tel number should have 10 digits: %s", tel)
+		}
+		if !strings.HasPrefix(tel, ac.code+"-") {
+			t.Errorf("Tel number should start with area code %s: %s", ac.code, tel)
+		}
+	}
+}
+
+func TestAreaCodesCoverAllPrefectures(t *testing.T) {
+	for _, p := range prefs {
+		found := false
+		for _, ac := range areaCodes {
+			if ac.prefecture == p {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Area code not found for %s", p)
+		}
+	}
+}
+
 func isTelNumber(s string) bool {
 	return telNumRegex.MatchString(s)
 }
@@ -221,6 +345,14 @@ func readCSV(csv string) [][]string {
 	return ss
 }
 
+func generateCSV(hdr string, n int, line func(i int) string) string {
+	lines := []string{hdr}
+	for i := 1; i <= n; i++ {
+		lines = append(lines, line(i))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
 func allOK(data [][]string, i int, f func(string) bool) bool {
 	return allOKNoHeader(data[1:], i, f)
 }
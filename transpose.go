@@ -0,0 +1,54 @@
+package csvutil
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// TransposeOption is option holder for Transpose.
+type TransposeOption struct {
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+}
+
+func (o TransposeOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Transpose swaps lines and columns of CSV.
+// Header line becomes the first column, and short lines are padded with empty values.
+func Transpose(r io.Reader, w io.Writer, o TransposeOption) error {
+	cr, bom := reader(r, o.Encoding)
+	cr.FieldsPerRecord = -1
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	recs, err := cr.ReadAll()
+	if err != nil {
+		return errors.Wrap(err, "cannot read csv line")
+	}
+
+	width := 0
+	for _, rec := range recs {
+		if len(rec) > width {
+			width = len(rec)
+		}
+	}
+
+	for i := 0; i < width; i++ {
+		newRec := make([]string, len(recs))
+		for j, rec := range recs {
+			if i < len(rec) {
+				newRec[j] = rec[i]
+			}
+		}
+		cw.Write(newRec)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package csvutil
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+func BenchmarkTranspose(b *testing.B) {
+	p, err := ioutil.ReadFile("testdata/bench.csv")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < b.N; i++ {
+		r := bytes.NewBuffer(p)
+		w := &bytes.Buffer{}
+		o := TransposeOption{}
+		Transpose(r, w, o)
+	}
+}
+
+func TestTransposeWithBrokenCSV(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,"5
+7,8,9
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TransposeOption{}
+
+	if err := Transpose(r, w, o); err == nil {
+		t.Fatal("Transpose with broken csv should raise error.")
+	}
+}
+
+func TestTransposeWithEmptyCSV(t *testing.T) {
+	r := bytes.NewBufferString("")
+	w := &bytes.Buffer{}
+	o := TransposeOption{}
+
+	if err := Transpose(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+	if w.String() != "" {
+		t.Fatalf("Expected empty, but got %s", w.String())
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2,3
+4,5,6
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TransposeOption{}
+
+	if err := Transpose(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,1,4
+bbb,2,5
+ccc,3,6
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestTransposeWithRaggedCSV(t *testing.T) {
+	s := `aaa,bbb,ccc
+1,2
+4,5,6,7
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TransposeOption{}
+
+	if err := Transpose(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,1,4
+bbb,2,5
+ccc,,6
+,,7
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
+
+func TestTransposeWithBOM(t *testing.T) {
+	s := "aaa,bbb\n1,2\n"
+	r := bytes.NewBuffer(append(UTF8BOM(), []byte(s)...))
+	w := &bytes.Buffer{}
+	o := TransposeOption{}
+
+	if err := Transpose(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := append(UTF8BOM(), []byte("aaa,1\nbbb,2\n")...)
+	if actual := w.Bytes(); !bytes.Equal(actual, expected) {
+		t.Fatalf("Expectd: %v, but got %v", expected, actual)
+	}
+}
+
+func TestTransposeWithOutputEncoding(t *testing.T) {
+	s := "名前,個数\nりんご,1\n"
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TransposeOption{
+		OutputEncoding: "sjis",
+	}
+
+	if err := Transpose(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := toUTF8(w.Bytes(), japanese.ShiftJIS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "名前,りんご\n個数,1\n"
+	if actual != expected {
+		t.Fatalf("Expectd: %s, but got %s", expected, actual)
+	}
+}
@@ -0,0 +1,110 @@
+package csvutil
+
+import (
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// TrimOption is option holder for Trim.
+type TrimOption struct {
+	// Source file does not have header line. (default false)
+	NoHeader bool
+	// Encoding of source file. (default utf8)
+	Encoding string
+	// Encoding for output.
+	OutputEncoding string
+	// ColumnSyms header or column index list. (default all columns)
+	ColumnSyms []string
+	// Collapse internal consecutive whitespaces into one.
+	Collapse bool
+	// Remove control characters.
+	RemoveControl bool
+}
+
+func (o TrimOption) validate() error {
+	if o.NoHeader {
+		for _, c := range o.ColumnSyms {
+			if !isDigit(c) {
+				return errors.New("not number column symbol")
+			}
+		}
+	}
+	return nil
+}
+
+func (o TrimOption) outputEncoding() string {
+	if o.OutputEncoding != "" {
+		return o.OutputEncoding
+	}
+	return o.Encoding
+}
+
+// Trim leading and trailing whitespaces of given columns.
+// Both ASCII and full width spaces are trimmed.
+func Trim(r io.Reader, w io.Writer, o TrimOption) error {
+	if err := o.validate(); err != nil {
+		return errors.Wrap(err, "invalid option")
+	}
+
+	cr, bom := reader(r, o.Encoding)
+	cw := writer(w, bom, o.outputEncoding())
+	defer cw.Flush()
+
+	var cols columns
+	csvp := NewCSVProcessor(cr, cw)
+	if o.NoHeader {
+		csvp.SetPreBodyRead(func() error {
+			cols = newUniqueColumns(o.ColumnSyms, nil)
+			return cols.err()
+		})
+	} else {
+		csvp.SetHeaderHanlder(func(hdr []string) ([]string, error) {
+			cols = newUniqueColumns(o.ColumnSyms, hdr)
+			return hdr, cols.err()
+		})
+	}
+	csvp.SetRecordHandler(func(rec []string) ([]string, error) {
+		for i := range rec {
+			if len(cols) == 0 || containsColumnIndex(cols, i) {
+				rec[i] = o.trim(rec[i])
+			}
+		}
+		return rec, nil
+	})
+
+	return csvp.Process()
+}
+
+func (o TrimOption) trim(s string) string {
+	if o.RemoveControl {
+		s = strings.Map(func(r rune) rune {
+			if unicode.IsControl(r) {
+				return -1
+			}
+			return r
+		}, s)
+	}
+	s = strings.TrimFunc(s, unicode.IsSpace)
+	if o.Collapse {
+		s = collapseSpaces(s)
+	}
+	return s
+}
+
+// collapseSpaces replaces consecutive whitespaces with the first one.
+func collapseSpaces(s string) string {
+	rs := make([]rune, 0, len(s))
+	prevSpace := false
+	for _, r := range s {
+		sp := unicode.IsSpace(r)
+		if sp && prevSpace {
+			continue
+		}
+		rs = append(rs, r)
+		prevSpace = sp
+	}
+	return string(rs)
+}
@@ -0,0 +1,137 @@
+package csvutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTrimWithNoHeaderButColumnNotNumber(t *testing.T) {
+	s := ` 1 ,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TrimOption{
+		NoHeader:   true,
+		ColumnSyms: []string{"aaa"},
+	}
+
+	if err := Trim(r, w, o); err == nil {
+		t.Fatal("Trim with not number column symbol for no header CSV should raise error.")
+	}
+}
+
+func TestTrimWithUnknownColumn(t *testing.T) {
+	s := `aaa,bbb
+ 1 ,2
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TrimOption{
+		ColumnSyms: []string{"ccc"},
+	}
+
+	if err := Trim(r, w, o); err == nil {
+		t.Fatal("Trim with unknown column should raise error.")
+	}
+}
+
+func TestTrimWithBrokenCSV(t *testing.T) {
+	s := `aaa,bbb
+ 1 ,2
+3
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TrimOption{}
+
+	if err := Trim(r, w, o); err == nil {
+		t.Fatal("Trim with broken csv should raise error.")
+	}
+}
+
+func TestTrim(t *testing.T) {
+	s := `aaa,bbb
+ 1 ,　2　
+"	3
+",4
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TrimOption{}
+
+	if err := Trim(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb
+1,2
+3,4
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestTrimWithColumns(t *testing.T) {
+	s := `aaa,bbb
+ 1 ,　2　
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TrimOption{
+		ColumnSyms: []string{"bbb"},
+	}
+
+	if err := Trim(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `aaa,bbb
+" 1 ",2
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestTrimWithNoHeader(t *testing.T) {
+	s := ` 1 , 2 
+`
+	r := bytes.NewBufferString(s)
+	w := &bytes.Buffer{}
+	o := TrimOption{
+		NoHeader:   true,
+		ColumnSyms: []string{"0"},
+	}
+
+	if err := Trim(r, w, o); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `1," 2 "
+`
+	if actual := w.String(); actual != expected {
+		t.Fatalf("Expected: %s, but got %s", expected, actual)
+	}
+}
+
+func TestTrimValue(t *testing.T) {
+	tests := []struct {
+		opt      TrimOption
+		src      string
+		expected string
+	}{
+		{TrimOption{}, "  山田  太郎  ", "山田  太郎"},
+		{TrimOption{}, "　山田　太郎　", "山田　太郎"},
+		{TrimOption{Collapse: true}, " 山田 　 太郎 ", "山田 太郎"},
+		{TrimOption{Collapse: true}, "山田　　太郎", "山田　太郎"},
+		{TrimOption{RemoveControl: true}, "山田\x00太郎\x7f", "山田太郎"},
+		{TrimOption{}, "山田\x00太郎", "山田\x00太郎"},
+		{TrimOption{RemoveControl: true, Collapse: true}, " a\x07 \t b ", "a b"},
+	}
+	for _, tt := range tests {
+		if actual := tt.opt.trim(tt.src); actual != tt.expected {
+			t.Errorf("Expected: %q, but got %q (%+v)", tt.expected, actual, tt.opt)
+		}
+	}
+}
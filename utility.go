@@ -59,6 +59,13 @@ func lot(n int) bool {
 	return rand.Intn(100) < n
 }
 
+func newRand(seed int64) *rand.Rand {
+	if seed == 0 {
+		seed = rand.Int63()
+	}
+	return rand.New(rand.NewSource(seed))
+}
+
 func sampleString(ss []string) string {
 	return ss[rand.Intn(len(ss))]
 }